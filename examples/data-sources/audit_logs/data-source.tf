@@ -0,0 +1,18 @@
+# List the deleted branches of the organization since the start of the year
+
+data "planetscale_audit_logs" "deleted_branches" {
+  organization = "my-org"
+  action       = "branch.deleted"
+  since        = "2023-01-01T00:00:00Z"
+  max_results  = 500
+}
+
+output "deleted_branches" {
+  value = [
+    for event in data.planetscale_audit_logs.deleted_branches.audit_logs : {
+      branch = event.auditable_display_name
+      actor  = event.actor_display_name
+      at     = event.created_at
+    }
+  ]
+}
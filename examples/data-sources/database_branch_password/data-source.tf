@@ -0,0 +1,22 @@
+# Data source for fetching a single database branch password
+
+# Fetch a password by its public ID
+data "planetscale_database_branch_password" "by_id" {
+  organization = "my-org"
+  database     = "my-database"
+  branch       = "main"
+  public_id    = "abcdefgh1234"
+}
+
+# Fetch a password by its name
+data "planetscale_database_branch_password" "by_name" {
+  organization = "my-org"
+  database     = "my-database"
+  branch       = "main"
+  name         = "my-password"
+}
+
+# Reference the username of an existing password from another stack
+output "username" {
+  value = data.planetscale_database_branch_password.by_name.username
+}
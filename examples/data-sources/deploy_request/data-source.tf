@@ -0,0 +1,11 @@
+# Display how getting the details of a specific deploy request and its deployment works.
+
+data "planetscale_deploy_request" "this" {
+  organization = "my-org"
+  database     = "my-database"
+  number       = 42
+}
+
+output "deploy_request_lint_errors" {
+  value = data.planetscale_deploy_request.this.deployment.lint_errors
+}
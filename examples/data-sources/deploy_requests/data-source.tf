@@ -1,13 +1,12 @@
-# Display how getting information for a specific deploy request works.
-# At this time, it is not possible to list deploy requests for a database. Therefore a data-source requires providing
-# the deploy request number.
+# Display how listing the deploy requests of a database works. All filters are optional.
 
-data "planetscale_deploy_requests" "this" {
+data "planetscale_deploy_requests" "open_into_main" {
   organization = "my-org"
   database     = "my-database"
-  number       = "test-deploy-request-number-here"
+  state        = "open"
+  into_branch  = "main"
 }
 
-output "deploy_request_info" {
-  value = data.planetscale_deploy_requests.this
-}
\ No newline at end of file
+output "open_deploy_requests_into_main" {
+  value = data.planetscale_deploy_requests.open_into_main.deploy_requests
+}
@@ -0,0 +1,9 @@
+# Get information about your organization
+
+data "planetscale_organization" "this" {
+  name = "my-org"
+}
+
+output "database_count" {
+  value = data.planetscale_organization.this.database_count
+}
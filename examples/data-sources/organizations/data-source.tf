@@ -0,0 +1,7 @@
+# List all organizations the configured service token has access to
+
+data "planetscale_organizations" "all" {}
+
+output "organization_names" {
+  value = data.planetscale_organizations.all.organizations[*].name
+}
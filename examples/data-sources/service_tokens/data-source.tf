@@ -0,0 +1,13 @@
+# List all service tokens of the organization together with their accesses
+
+data "planetscale_service_tokens" "all" {
+  organization = "my-org"
+}
+
+# Service tokens that can connect to a production branch
+output "production_service_tokens" {
+  value = [
+    for token in data.planetscale_service_tokens.all.service_tokens : token.id
+    if contains([for access in token.accesses : access.access], "connect_production_branch")
+  ]
+}
@@ -0,0 +1,2 @@
+# You can import a backup by providing the organization_name, the database_name, the branch_name and the backup_id
+terraform import planetscale_backup.example organization_name/database_name/branch_name/backup_id
@@ -0,0 +1,25 @@
+# Resource for managing database branch client certificates
+
+# Create a client certificate for a database branch
+resource "planetscale_database_branch_certificate" "example" {
+  organization = "my-org"
+  database     = "my-database"
+  branch       = "main"
+  name         = "my-service"
+  role         = "readwriter"
+}
+
+# Hand the key pair to a service connecting with mutual TLS
+output "client_certificate" {
+  value     = planetscale_database_branch_certificate.example.certificate_pem
+  sensitive = true
+}
+
+output "client_private_key" {
+  value     = planetscale_database_branch_certificate.example.private_key_pem
+  sensitive = true
+}
+
+output "client_certificate_expires_at" {
+  value = planetscale_database_branch_certificate.example.expires_at
+}
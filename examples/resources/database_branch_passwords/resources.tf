@@ -27,4 +27,21 @@ resource "planetscale_database_branch_password" "example" {
 
   # Optional
   role         = "admin"
-}
\ No newline at end of file
+}
+
+# Rotate a database branch password by changing its rotation triggers, e.g. from a monthly scheduled pipeline
+resource "planetscale_database_branch_password" "rotated" {
+  name         = "my-rotated-password"
+  database     = planetscale_database.this.name
+  organization = local.organization
+  branch       = planetscale_database_branch.example.name
+
+  rotation_triggers = {
+    month = var.rotation_month
+  }
+}
+# Use a ready-made connection string, e.g. for a Prisma application
+output "prisma_database_url" {
+  value     = planetscale_database_branch_password.example.connection_strings.prisma
+  sensitive = true
+}
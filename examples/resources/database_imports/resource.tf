@@ -0,0 +1,27 @@
+# Resource for importing an external MySQL database into Planetscale
+
+variable "source_password" {
+  type      = string
+  sensitive = true
+}
+
+# Start importing an external database, Planetscale runs as a replica once the data has been copied
+resource "planetscale_database_import" "example" {
+  organization = "my-org"
+  database     = "my-imported-database"
+
+  source = {
+    hostname = "my-database.abcdefgh1234.eu-west-1.rds.amazonaws.com"
+    database = "app"
+    username = "migration"
+    password = var.source_password
+
+    # Optional
+    port     = 3306
+    ssl_mode = "verify_identity"
+  }
+
+  # Change to primary to make Planetscale the primary, then set detach_external_database to finish the import
+  mode                     = "replica"
+  detach_external_database = false
+}
@@ -0,0 +1,20 @@
+# Display how to approve a deploy request with the deploy request reviews resource. The provider configured for this
+# resource should use a different service token than the one that opened the deploy request, as PlanetScale does not
+# allow approving your own deploy requests.
+
+resource "planetscale_deploy_request" "this" {
+  organization = "my-org"
+  database     = "my-db"
+  branch       = "my-feature-branch"
+  into_branch  = "main"
+}
+
+resource "planetscale_deploy_request_review" "approval" {
+  provider = planetscale.approver
+
+  organization = planetscale_deploy_request.this.organization
+  database     = planetscale_deploy_request.this.database
+  number       = planetscale_deploy_request.this.number
+  state        = "approved"
+  body         = "Approved by the change-management pipeline."
+}
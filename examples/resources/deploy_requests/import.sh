@@ -0,0 +1,2 @@
+# You can import a deploy request by providing the organization_name, the database_name and the deploy request number
+terraform import planetscale_deploy_request.example organization_name/database_name/number
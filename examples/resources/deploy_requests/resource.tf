@@ -18,4 +18,27 @@ resource "planetscale_deploy_request" "new_deploy_reqest" {
   database     = local.database
   branch       = "my-existing-tf-branch"
   into_branch  = planetscale_database_branch.destination_branch.name
-}
\ No newline at end of file
+}
+
+# The revert window action can only be set once the deploy request exists. Create it with "none", then, once it has
+# been deployed, change the value to "revert" to roll back its schema changes while the revert window is still open,
+# or to "skip_revert" to close the revert window instead.
+resource "planetscale_deploy_request" "deployed_deploy_request" {
+  organization         = local.organization
+  database             = local.database
+  branch               = "my-deployed-branch"
+  into_branch          = "main"
+  revert_window_action = var.revert_window_action # "none" on creation, "revert" or "skip_revert" once deployed
+}
+
+# Gated deployments: with auto_apply disabled a deployed deploy request is staged until it is applied. Create the
+# deploy request with apply set to false, then, once it has been deployed, flip apply to true, e.g. from a pipeline
+# running in a maintenance window, to cut over the staged schema changes.
+resource "planetscale_deploy_request" "gated_deploy_request" {
+  organization = local.organization
+  database     = local.database
+  branch       = "my-gated-branch"
+  into_branch  = "main"
+  auto_apply   = false
+  apply        = var.maintenance_window_open
+}
@@ -0,0 +1,26 @@
+# Display how to mint a service token for an application
+
+resource "planetscale_service_token" "my_app" {
+  organization = "my-org"
+}
+
+output "my_app_service_token_id" {
+  value = planetscale_service_token.my_app.id
+}
+
+output "my_app_service_token" {
+  value     = planetscale_service_token.my_app.token
+  sensitive = true
+}
+
+# Grant the service token least-privilege access on a single database
+resource "planetscale_service_token_access" "my_app" {
+  organization     = planetscale_service_token.my_app.organization
+  service_token_id = planetscale_service_token.my_app.id
+  database         = "my-db"
+  accesses = [
+    "read_branch",
+    "create_deploy_request",
+    "connect_production_branch",
+  ]
+}
@@ -0,0 +1,320 @@
+package planetscale
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/planetscale/planetscale-go/planetscale"
+)
+
+const (
+	// auditLogsDefaultMaxResults is the number of audit logs returned when max_results is not set.
+	auditLogsDefaultMaxResults = 100
+	// auditLogsPageSize is the number of audit logs requested per page.
+	auditLogsPageSize = 100
+)
+
+// auditLogsDataSourceModel maps the data source schema data.
+type auditLogsDataSourceModel struct {
+	Organization types.String    `tfsdk:"organization"`
+	Action       types.String    `tfsdk:"action"`
+	Actor        types.String    `tfsdk:"actor"`
+	Since        types.String    `tfsdk:"since"`
+	Until        types.String    `tfsdk:"until"`
+	MaxResults   types.Int64     `tfsdk:"max_results"`
+	AuditLogs    []auditLogModel `tfsdk:"audit_logs"`
+}
+
+// auditLogModel maps audit log schema data.
+type auditLogModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Action               types.String `tfsdk:"action"`
+	AuditAction          types.String `tfsdk:"audit_action"`
+	ActorID              types.String `tfsdk:"actor_id"`
+	ActorType            types.String `tfsdk:"actor_type"`
+	ActorDisplayName     types.String `tfsdk:"actor_display_name"`
+	AuditableID          types.String `tfsdk:"auditable_id"`
+	AuditableType        types.String `tfsdk:"auditable_type"`
+	AuditableDisplayName types.String `tfsdk:"auditable_display_name"`
+	TargetID             types.String `tfsdk:"target_id"`
+	TargetType           types.String `tfsdk:"target_type"`
+	TargetDisplayName    types.String `tfsdk:"target_display_name"`
+	Location             types.String `tfsdk:"location"`
+	RemoteIP             types.String `tfsdk:"remote_ip"`
+	Metadata             types.String `tfsdk:"metadata"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+}
+
+func NewAuditLogsDataSource() datasource.DataSource {
+	return &auditLogsDataSource{}
+}
+
+type auditLogsDataSource struct {
+	client *planetscale.Client
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &auditLogsDataSource{}
+	_ datasource.DataSourceWithConfigure = &auditLogsDataSource{}
+)
+
+func (d *auditLogsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_logs"
+}
+
+// Schema defines the schema for the data source.
+func (d *auditLogsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List of audit log events of the organization, most recent first. The events can be filtered by " +
+			"action, actor and time range. For more information on audit logs, see here: " +
+			"https://planetscale.com/docs/concepts/audit-log.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the organization to list audit logs for.",
+			},
+			"action": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list events of the given action, e.g. branch.deleted or deploy_request.approved.",
+			},
+			"actor": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list events performed by the given actor. Matches either the ID or the display name of the actor.",
+			},
+			"since": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list events that happened at or after the given RFC3339 timestamp.",
+			},
+			"until": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list events that happened before the given RFC3339 timestamp.",
+			},
+			"max_results": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of events to return. Defaults to 100.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"audit_logs": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of audit log events.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the event.",
+						},
+						"action": schema.StringAttribute{
+							Computed:    true,
+							Description: "The action of the event, e.g. deleted.",
+						},
+						"audit_action": schema.StringAttribute{
+							Computed:    true,
+							Description: "The full action of the event, e.g. branch.deleted.",
+						},
+						"actor_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the actor who performed the action.",
+						},
+						"actor_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the actor who performed the action.",
+						},
+						"actor_display_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The display name of the actor who performed the action.",
+						},
+						"auditable_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the object the action was performed on.",
+						},
+						"auditable_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the object the action was performed on.",
+						},
+						"auditable_display_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The display name of the object the action was performed on.",
+						},
+						"target_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the target of the action.",
+						},
+						"target_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the target of the action.",
+						},
+						"target_display_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The display name of the target of the action.",
+						},
+						"location": schema.StringAttribute{
+							Computed:    true,
+							Description: "The location the action was performed from.",
+						},
+						"remote_ip": schema.StringAttribute{
+							Computed:    true,
+							Description: "The IP address the action was performed from.",
+						},
+						"metadata": schema.StringAttribute{
+							Computed:    true,
+							Description: "Additional metadata of the event, encoded as JSON.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The time the event happened.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *auditLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state auditLogsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var since, until time.Time
+	var err error
+	if !state.Since.IsNull() {
+		since, err = time.Parse(time.RFC3339, state.Since.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("since"),
+				"Invalid since timestamp",
+				"The since timestamp must be in RFC3339 format, e.g. 2023-01-02T15:04:05Z: "+err.Error(),
+			)
+		}
+	}
+	if !state.Until.IsNull() {
+		until, err = time.Parse(time.RFC3339, state.Until.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("until"),
+				"Invalid until timestamp",
+				"The until timestamp must be in RFC3339 format, e.g. 2023-01-02T15:04:05Z: "+err.Error(),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxResults := auditLogsDefaultMaxResults
+	if !state.MaxResults.IsNull() {
+		maxResults = int(state.MaxResults.ValueInt64())
+	}
+
+	listReq := &planetscale.ListAuditLogsRequest{
+		Organization: state.Organization.ValueString(),
+	}
+	if !state.Action.IsNull() {
+		listReq.Events = []planetscale.AuditLogEvent{planetscale.AuditLogEvent(state.Action.ValueString())}
+	}
+
+	ctx = tflog.SetField(ctx, "organization", state.Organization.ValueString())
+	ctx = tflog.SetField(ctx, "action", state.Action.ValueString())
+
+	tflog.Info(ctx, "requesting audit logs listing from Planetscale")
+
+	// Audit logs are returned most recent first, therefore paging stops as soon as an event older than since is
+	// found, the last page has been reached or enough events have been collected.
+	var startingAfter string
+	for {
+		page, err := d.client.AuditLogs.List(ctx, listReq, planetscale.WithLimit(auditLogsPageSize), planetscale.WithStartingAfter(startingAfter))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read Planetscale audit logs. Make sure your credentials are correct and you have the "+
+					"correct permissions to read the audit logs of the organization.",
+				err.Error(),
+			)
+			return
+		}
+
+		reachedSince := false
+		for _, auditLog := range page.Data {
+			if !since.IsZero() && auditLog.CreatedAt.Before(since) {
+				reachedSince = true
+				break
+			}
+			if !until.IsZero() && !auditLog.CreatedAt.Before(until) {
+				continue
+			}
+			if !state.Actor.IsNull() && state.Actor.ValueString() != auditLog.ActorID &&
+				state.Actor.ValueString() != auditLog.ActorDisplayName {
+				continue
+			}
+
+			metadata, err := json.Marshal(auditLog.Metadata)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to encode metadata of Planetscale audit log "+auditLog.ID,
+					err.Error(),
+				)
+				return
+			}
+
+			state.AuditLogs = append(state.AuditLogs, auditLogModel{
+				ID:                   types.StringValue(auditLog.ID),
+				Action:               types.StringValue(auditLog.Action),
+				AuditAction:          types.StringValue(auditLog.AuditAction),
+				ActorID:              types.StringValue(auditLog.ActorID),
+				ActorType:            types.StringValue(auditLog.ActorType),
+				ActorDisplayName:     types.StringValue(auditLog.ActorDisplayName),
+				AuditableID:          types.StringValue(auditLog.AuditableID),
+				AuditableType:        types.StringValue(auditLog.AuditableType),
+				AuditableDisplayName: types.StringValue(auditLog.AuditableDisplayName),
+				TargetID:             types.StringValue(auditLog.TargetID),
+				TargetType:           types.StringValue(auditLog.TargetType),
+				TargetDisplayName:    types.StringValue(auditLog.TargetDisplayName),
+				Location:             types.StringValue(auditLog.Location),
+				RemoteIP:             types.StringValue(auditLog.RemoteIP),
+				Metadata:             types.StringValue(string(metadata)),
+				CreatedAt:            types.StringValue(auditLog.CreatedAt.String()),
+			})
+			if len(state.AuditLogs) >= maxResults {
+				break
+			}
+		}
+
+		if reachedSince || len(state.AuditLogs) >= maxResults || !page.HasNext || page.CursorEnd == nil {
+			break
+		}
+		startingAfter = *page.CursorEnd
+	}
+
+	tflog.Debug(ctx, "returning audit logs listing from Planetscale", map[string]interface{}{
+		"count": len(state.AuditLogs),
+	})
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *auditLogsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*planetscale.Client)
+}
@@ -2,6 +2,9 @@ package planetscale
 
 import (
 	"context"
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -10,8 +13,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &backupResource{}
-	_ resource.ResourceWithConfigure = &backupResource{}
+	_ resource.Resource                = &backupResource{}
+	_ resource.ResourceWithConfigure   = &backupResource{}
+	_ resource.ResourceWithImportState = &backupResource{}
 )
 
 type backupResourceModel struct {
@@ -72,7 +76,6 @@ func (r *backupResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"public_id": schema.StringAttribute{
 				Computed:    true,
-				Optional:    true,
 				Description: "The public ID of the backup.",
 			},
 			"created_at": schema.StringAttribute{
@@ -165,19 +168,23 @@ func (r *backupResource) Read(ctx context.Context, req resource.ReadRequest, res
 		Organization: state.Organization.ValueString(),
 		Database:     state.Database.ValueString(),
 		Branch:       state.Branch.ValueString(),
+		Backup:       state.PublicID.ValueString(),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Planetscale backup",
-			"Could not read info about Planetscale database "+state.Database.ValueString()+" on branch"+
-				""+state.Branch.ValueString()+": "+err.Error(),
+			"Could not read info about Planetscale backup "+state.PublicID.ValueString()+" of database "+
+				state.Database.ValueString()+" on branch "+state.Branch.ValueString()+": "+err.Error(),
 		)
 		return
 	}
 
+	state.Name = types.StringValue(backup.Name)
 	state.State = types.StringValue(backup.State)
 	state.Size = types.Int64Value(backup.Size)
 	state.UpdatedAt = types.StringValue(backup.UpdatedAt.String())
+	state.StartedAt = types.StringValue(backup.StartedAt.String())
+	state.ExpiresAt = types.StringValue(backup.ExpiresAt.String())
 	state.CompletedAt = types.StringValue(backup.CompletedAt.String())
 
 	// Set refreshed state
@@ -235,3 +242,69 @@ func (r *backupResource) Configure(_ context.Context, req resource.ConfigureRequ
 
 	r.client = req.ProviderData.(*planetscale.Client)
 }
+
+func splitBackupResourceID(id string) (organizationName, databaseName, branchName, backupID string, ok bool) {
+	attributes := strings.Split(id, "/")
+	requiredAttributesLength := 4
+	if len(attributes) != requiredAttributesLength {
+		return "", "", "", "", false
+	}
+	return attributes[0], attributes[1], attributes[2], attributes[3], true
+}
+
+func (r *backupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organizationName, databaseName, branchName, backupID, ok := splitBackupResourceID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing backup",
+			fmt.Sprintf("Invalid input '%s' provided. should be in format \"organization_name/database_name/branch_name/backup_id\"", req.ID),
+		)
+		return
+	}
+
+	out, err := r.client.Backups.Get(ctx, &planetscale.GetBackupRequest{
+		Organization: organizationName,
+		Database:     databaseName,
+		Branch:       branchName,
+		Backup:       backupID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading backup",
+			fmt.Sprintf("Could not get backup %s %s %s %s, unexpected error: %v",
+				organizationName,
+				databaseName,
+				branchName,
+				backupID,
+				err,
+			),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "imported backup", map[string]interface{}{
+		"organization": organizationName,
+		"database":     databaseName,
+		"branch":       branchName,
+		"backup":       backupID,
+	})
+
+	diags := resp.State.Set(ctx, &backupResourceModel{
+		Organization: types.StringValue(organizationName),
+		Database:     types.StringValue(databaseName),
+		Branch:       types.StringValue(branchName),
+		PublicID:     types.StringValue(out.PublicID),
+		Name:         types.StringValue(out.Name),
+		State:        types.StringValue(out.State),
+		CreatedAt:    types.StringValue(out.CreatedAt.String()),
+		UpdatedAt:    types.StringValue(out.UpdatedAt.String()),
+		StartedAt:    types.StringValue(out.StartedAt.String()),
+		ExpiresAt:    types.StringValue(out.ExpiresAt.String()),
+		CompletedAt:  types.StringValue(out.CompletedAt.String()),
+		Size:         types.Int64Value(out.Size),
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,312 @@
+package planetscale
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/planetscale/planetscale-go/planetscale"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var (
+	_ resource.Resource              = &databaseBranchCertificateResource{}
+	_ resource.ResourceWithConfigure = &databaseBranchCertificateResource{}
+)
+
+type databaseBranchCertificateResourceModel struct {
+	Organization   types.String `tfsdk:"organization"`
+	Database       types.String `tfsdk:"database"`
+	Branch         types.String `tfsdk:"branch"`
+	Name           types.String `tfsdk:"name"`
+	Role           types.String `tfsdk:"role"`
+	PublicID       types.String `tfsdk:"public_id"`
+	PrivateKeyPEM  types.String `tfsdk:"private_key_pem"`
+	CertificatePEM types.String `tfsdk:"certificate_pem"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+// NewDatabaseBranchCertificateResource is a helper function to simplify the provider implementation.
+func NewDatabaseBranchCertificateResource() resource.Resource {
+	return &databaseBranchCertificateResource{}
+}
+
+// databaseBranchCertificateResource is the resource implementation.
+type databaseBranchCertificateResource struct {
+	client *planetscale.Client
+}
+
+// Metadata returns the resource type name.
+func (r *databaseBranchCertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_branch_certificate"
+}
+
+// Schema defines the schema for the resource.
+func (r *databaseBranchCertificateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A client certificate to connect to a database branch with mutual TLS instead of a password. " +
+			"The private key is generated locally and only its certificate signing request is sent to Planetscale. " +
+			"The Planetscale Golang SDK does not support deleting certificates yet, therefore destroying this " +
+			"resource only removes it from the Terraform state and the certificate stays valid until it expires.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the database.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the branch.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The display name of the certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Required: true,
+				Description: "The role of the certificate. Once a certificate is created, its role cannot be changed. " +
+					"Supported values: admin, reader, writer, readwriter.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"admin",
+						"reader",
+						"writer",
+						"readwriter",
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"public_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The public ID of the certificate.",
+			},
+			"private_key_pem": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded PKCS#8 ECDSA private key of the certificate.",
+			},
+			"certificate_pem": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded client certificate signed by Planetscale.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the certificate expires.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the certificate was created.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *databaseBranchCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan databaseBranchCertificateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The private key never leaves the machine, the SDK only sends a certificate signing request for it
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating database branch certificate",
+			"Could not generate private key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating database branch certificate",
+			"Could not encode private key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// create resource on Planetscale
+	certificate, err := r.client.Certificates.Create(ctx, &planetscale.DatabaseBranchCertificateRequest{
+		Organization: plan.Organization.ValueString(),
+		Database:     plan.Database.ValueString(),
+		Branch:       plan.Branch.ValueString(),
+		DisplayName:  plan.Name.ValueString(),
+		Role:         plan.Role.ValueString(),
+		PrivateKey:   privateKey,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating database branch certificate",
+			"Could not create database branch certificate, unexpected error: "+err.Error()+". Make sure the "+
+				"branch exists and that you have the correct permissions.",
+		)
+		return
+	}
+
+	// The certificate cannot be deleted through the SDK and the private key only exists here, therefore a certificate
+	// which cannot be parsed is kept without its expiry rather than discarded
+	plan.ExpiresAt = types.StringNull()
+	expiresAt, err := certificateExpiry(certificate.Certificate)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to read database branch certificate expiry",
+			"Could not parse certificate "+certificate.PublicID+" returned by Planetscale, therefore expires_at is "+
+				"empty: "+err.Error(),
+		)
+	} else {
+		plan.ExpiresAt = types.StringValue(expiresAt.String())
+	}
+
+	plan.PublicID = types.StringValue(certificate.PublicID)
+	plan.PrivateKeyPEM = types.StringValue(string(pem.EncodeToMemory(&pem.Block{
+		Type:  "PRIVATE KEY",
+		Bytes: privateKeyBytes,
+	})))
+	plan.CertificatePEM = types.StringValue(certificate.Certificate)
+	plan.CreatedAt = types.StringValue(certificate.CreatedAt.String())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *databaseBranchCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state databaseBranchCertificateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Certificates cannot be fetched by their public ID, therefore look up the certificate in the listing
+	certificates, err := r.client.Certificates.List(ctx, &planetscale.ListDatabaseBranchCertificateRequest{
+		Organization: state.Organization.ValueString(),
+		Database:     state.Database.ValueString(),
+		Branch:       state.Branch.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Planetscale database branch certificate",
+			"Could not read info about Planetscale database branch certificate "+state.Name.ValueString()+": "+
+				err.Error()+". Make sure the branch exists and that you have the correct permissions.",
+		)
+		return
+	}
+
+	var certificate *planetscale.DatabaseBranchCertificate
+	for _, c := range certificates {
+		if c.PublicID == state.PublicID.ValueString() {
+			certificate = c
+			break
+		}
+	}
+
+	if certificate == nil || !certificate.DeletedAt.IsZero() {
+		tflog.Warn(ctx, "database branch certificate not found, removing it from state", map[string]interface{}{
+			"public_id": state.PublicID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.Name = types.StringValue(certificate.Name)
+	state.Role = types.StringValue(certificate.Role)
+	state.CreatedAt = types.StringValue(certificate.CreatedAt.String())
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *databaseBranchCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// every configurable attribute requires replacement, so there is nothing to update in place
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *databaseBranchCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state databaseBranchCertificateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// not supported by the golang sdk (https://github.com/planetscale/planetscale-go) yet, so the certificate is only
+	// removed from the state
+	resp.Diagnostics.AddWarning(
+		"Database branch certificate not revoked",
+		"The Planetscale Golang SDK does not support deleting certificates yet. The certificate "+
+			state.PublicID.ValueString()+" has been removed from the Terraform state but stays valid until it "+
+			"expires or is deleted in the Planetscale dashboard.",
+	)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *databaseBranchCertificateResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*planetscale.Client)
+}
+
+// certificateExpiry returns the expiry of the first certificate in the given PEM encoded certificate chain.
+func certificateExpiry(certificatePEM string) (time.Time, error) {
+	block, _ := pem.Decode([]byte(certificatePEM))
+	if block == nil {
+		return time.Time{}, errors.New("no PEM encoded certificate found")
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return certificate.NotAfter, nil
+}
@@ -2,8 +2,12 @@ package planetscale
 
 import (
 	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/planetscale/planetscale-go/planetscale"
@@ -11,20 +15,15 @@ import (
 
 // databaseBranchPasswordDataSourceModel maps the data source schema data.
 type databaseBranchPasswordDataSourceModel struct {
-	Organization types.String                  `tfsdk:"organization"`
-	Database     types.String                  `tfsdk:"database"`
-	Branch       types.String                  `tfsdk:"branch"`
-	Passwords    []databaseBranchPasswordModel `tfsdk:"passwords"`
-}
-
-// databaseBranchPasswordModel maps the database branch password model schema data.
-type databaseBranchPasswordModel struct {
-	Name      types.String `tfsdk:"name"`
-	Username  types.String `tfsdk:"username"`
-	Hostname  types.String `tfsdk:"hostname"`
-	PlainText types.String `tfsdk:"plaintext"`
-	PublicID  types.String `tfsdk:"public_id"`
-	Role      types.String `tfsdk:"role"`
+	Organization types.String `tfsdk:"organization"`
+	Database     types.String `tfsdk:"database"`
+	Branch       types.String `tfsdk:"branch"`
+	PublicID     types.String `tfsdk:"public_id"`
+	Name         types.String `tfsdk:"name"`
+	Username     types.String `tfsdk:"username"`
+	Hostname     types.String `tfsdk:"hostname"`
+	Role         types.String `tfsdk:"role"`
+	CreatedAt    types.String `tfsdk:"created_at"`
 }
 
 func NewDatabaseBranchPasswordDataSource() datasource.DataSource {
@@ -42,62 +41,58 @@ var (
 )
 
 func (d *databaseBranchPasswordDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_database_branch_passwords"
+	resp.TypeName = req.ProviderTypeName + "_database_branch_password"
 }
 
 // Schema defines the schema for the data source.
 func (d *databaseBranchPasswordDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "The database branch passwords data source provides a list of passwords for a database branch. At" +
-			" least one of organization, database or branch has to be provided for filtering. If you need to get a full" +
-			" list of passwords for all branches, you can filter by your organization name. For more information on " +
-			"database branch passwords, see here: https://planetscale.com/docs/concepts/connection-strings.",
+		Description: "A single password of a database branch, looked up either by its public ID or by its name. The " +
+			"plaintext of a password is only returned by Planetscale when the password is created, therefore it is " +
+			"not available in this data source. For more information on database branch passwords, see here: " +
+			"https://planetscale.com/docs/concepts/connection-strings.",
 		Attributes: map[string]schema.Attribute{
 			"organization": schema.StringAttribute{
-				Optional:    true,
-				Description: "The name of the organization that the database belongs to. ",
+				Required:    true,
+				Description: "The name of the organization that the database belongs to.",
 			},
 			"database": schema.StringAttribute{
-				Optional:    true,
+				Required:    true,
 				Description: "The name of the database that the branch belongs to.",
 			},
 			"branch": schema.StringAttribute{
-				Optional:    true,
-				Description: "The name of the branch that the passwords belong to.",
+				Required:    true,
+				Description: "The name of the branch that the password belongs to.",
 			},
-			"passwords": schema.ListNestedAttribute{
+			"public_id": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "A list of passwords for the database branch. ",
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Computed:    true,
-							Description: "The name of the password.",
-						},
-						"username": schema.StringAttribute{
-							Computed:    true,
-							Description: "The username for this password.",
-						},
-						"hostname": schema.StringAttribute{
-							Computed:    true,
-							Description: "The hostname for this password.",
-						},
-						"plaintext": schema.StringAttribute{
-							Computed:    true,
-							Sensitive:   true,
-							Description: "The plaintext password for this password. This value is sensitive and will not be stored in the state file.",
-						},
-						"public_id": schema.StringAttribute{
-							Computed:    true,
-							Description: "The public ID for this password.",
-						},
-						"role": schema.StringAttribute{
-							Computed:    true,
-							Description: "The role for this password.",
-						},
-					},
+				Description: "The public ID of the password to get. Exactly one of public_id or name has to be provided.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
 				},
 			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the password to get. Exactly one of public_id or name has to be provided.",
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "The username for this password.",
+			},
+			"hostname": schema.StringAttribute{
+				Computed:    true,
+				Description: "The hostname for this password.",
+			},
+			"role": schema.StringAttribute{
+				Computed:    true,
+				Description: "The role for this password.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the password was created.",
+			},
 		},
 	}
 }
@@ -106,42 +101,78 @@ func (d *databaseBranchPasswordDataSource) Read(ctx context.Context, req datasou
 	var state databaseBranchPasswordDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
-
-	ctx = tflog.SetField(ctx, "organization", state.Organization)
-	ctx = tflog.SetField(ctx, "database", state.Database)
-	ctx = tflog.SetField(ctx, "branch", state.Branch)
-
-	// todo: add validation for required fields
-	// todo: add validation for organization, database, and branch existence
-
-	tflog.Info(ctx, "requesting database branch passwords listing from Planetscale")
-	databaseBranchPasswords, err := d.client.Passwords.List(ctx, &planetscale.ListDatabaseBranchPasswordRequest{
-		Organization: state.Organization.ValueString(),
-		Database:     state.Database.ValueString(),
-		Branch:       state.Branch.ValueString(),
-	},
-	)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to read Planetscale database branch passwords. Make sure the organization, database, or branch exist and that you have the correct permissions.",
-			err.Error(),
-		)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	for _, databaseBranchPassword := range databaseBranchPasswords {
-		databaseBranchPasswordsState := databaseBranchPasswordModel{
-			Name:      types.StringValue(databaseBranchPassword.Name),
-			Username:  types.StringValue(databaseBranchPassword.Username),
-			Hostname:  types.StringValue(databaseBranchPassword.Hostname),
-			PlainText: types.StringValue(databaseBranchPassword.PlainText),
-			PublicID:  types.StringValue(databaseBranchPassword.PublicID),
-			Role:      types.StringValue(databaseBranchPassword.Role),
+	ctx = tflog.SetField(ctx, "organization", state.Organization.ValueString())
+	ctx = tflog.SetField(ctx, "database", state.Database.ValueString())
+	ctx = tflog.SetField(ctx, "branch", state.Branch.ValueString())
+
+	var databaseBranchPassword *planetscale.DatabaseBranchPassword
+	if !state.PublicID.IsNull() {
+		ctx = tflog.SetField(ctx, "public_id", state.PublicID.ValueString())
+
+		tflog.Info(ctx, "requesting database branch password from Planetscale")
+		out, err := d.client.Passwords.Get(ctx, &planetscale.GetDatabaseBranchPasswordRequest{
+			Organization: state.Organization.ValueString(),
+			Database:     state.Database.ValueString(),
+			Branch:       state.Branch.ValueString(),
+			PasswordId:   state.PublicID.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read Planetscale database branch password. Make sure the password exists and that you "+
+					"have the correct permissions.",
+				err.Error(),
+			)
+			return
+		}
+		databaseBranchPassword = out
+	} else {
+		ctx = tflog.SetField(ctx, "name", state.Name.ValueString())
+
+		// Passwords can only be fetched by their public ID, therefore look up the password by name in the listing
+		tflog.Info(ctx, "requesting database branch passwords listing from Planetscale")
+		databaseBranchPasswords, err := d.client.Passwords.List(ctx, &planetscale.ListDatabaseBranchPasswordRequest{
+			Organization: state.Organization.ValueString(),
+			Database:     state.Database.ValueString(),
+			Branch:       state.Branch.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read Planetscale database branch passwords. Make sure the organization, database and "+
+					"branch exist and that you have the correct permissions.",
+				err.Error(),
+			)
+			return
+		}
+
+		for _, password := range databaseBranchPasswords {
+			if password.Name == state.Name.ValueString() {
+				databaseBranchPassword = password
+				break
+			}
+		}
+		if databaseBranchPassword == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Database branch password not found",
+				"No password named "+state.Name.ValueString()+" exists on branch "+state.Branch.ValueString()+
+					" of database "+state.Database.ValueString()+".",
+			)
+			return
 		}
-		state.Passwords = append(state.Passwords, databaseBranchPasswordsState)
 	}
 
-	tflog.Debug(ctx, "returning database branch passwords listing from Planetscale")
+	state.PublicID = types.StringValue(databaseBranchPassword.PublicID)
+	state.Name = types.StringValue(databaseBranchPassword.Name)
+	state.Username = types.StringValue(databaseBranchPassword.Username)
+	state.Hostname = types.StringValue(databaseBranchPassword.Hostname)
+	state.Role = types.StringValue(databaseBranchPassword.Role)
+	state.CreatedAt = types.StringValue(databaseBranchPassword.CreatedAt.String())
+
+	tflog.Debug(ctx, "returning database branch password from Planetscale")
 
 	// Set state
 	diags := resp.State.Set(ctx, &state)
@@ -3,9 +3,14 @@ package planetscale
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,14 +26,27 @@ var (
 )
 
 type databaseBranchPasswordResourceModel struct {
-	Name         types.String `tfsdk:"name"`
-	Branch       types.String `tfsdk:"branch"`
-	Database     types.String `tfsdk:"database"`
-	Organization types.String `tfsdk:"organization"`
-	Role         types.String `tfsdk:"role"`
-	PublicID     types.String `tfsdk:"public_id"`
-	Username     types.String `tfsdk:"username"`
-	Plaintext    types.String `tfsdk:"plaintext"`
+	Name              types.String                                  `tfsdk:"name"`
+	Branch            types.String                                  `tfsdk:"branch"`
+	Database          types.String                                  `tfsdk:"database"`
+	Organization      types.String                                  `tfsdk:"organization"`
+	Role              types.String                                  `tfsdk:"role"`
+	PublicID          types.String                                  `tfsdk:"public_id"`
+	Username          types.String                                  `tfsdk:"username"`
+	Plaintext         types.String                                  `tfsdk:"plaintext"`
+	Hostname          types.String                                  `tfsdk:"hostname"`
+	CreatedAt         types.String                                  `tfsdk:"created_at"`
+	RotationTriggers  types.Map                                     `tfsdk:"rotation_triggers"`
+	ConnectionStrings *databaseBranchPasswordConnectionStringsModel `tfsdk:"connection_strings"`
+}
+
+type databaseBranchPasswordConnectionStringsModel struct {
+	Go       types.String `tfsdk:"go"`
+	MySQLURL types.String `tfsdk:"mysql_url"`
+	Prisma   types.String `tfsdk:"prisma"`
+	Rails    types.String `tfsdk:"rails"`
+	JDBC     types.String `tfsdk:"jdbc"`
+	PHPPDO   types.String `tfsdk:"php_pdo"`
 }
 
 // NewDatabaseBranchPasswordResource is a helper function to simplify the provider implementation.
@@ -52,26 +70,42 @@ func (r *databaseBranchPasswordResource) Schema(_ context.Context, _ resource.Sc
 		Description: "The database branch password resource allows you to manage a database branch password in " +
 			"Planetscale. This resource is used to create, read, update, and delete database branch passwords. For more" +
 			" information on database branch passwords, see the Planetscale documentation at " +
-			"https://planetscale.com/docs/concepts/connection-strings",
+			"https://planetscale.com/docs/concepts/connection-strings. Passwords which have been deleted outside of " +
+			"Terraform are removed from the state and created again on the next apply, while a name or role changed " +
+			"outside of Terraform replaces the password. The Planetscale Golang SDK does not return the expiry of a " +
+			"password, therefore it is not available.",
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the database branch password.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"branch": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the branch.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"database": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the database.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"organization": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"role": schema.StringAttribute{
 				Optional: true,
+				Computed: true,
 				Description: "The role of the database branch password. Defaults to admin. Once a password is created, " +
 					"its role cannot be changed. Supported values: admin, reader, writer, readwriter.",
 				Validators: []validator.String{
@@ -82,6 +116,20 @@ func (r *databaseBranchPasswordResource) Schema(_ context.Context, _ resource.Sc
 						"readwriter",
 					),
 				},
+				PlanModifiers: []planmodifier.String{
+					// Keep the role returned by Planetscale when none is configured, so it does not force a replacement
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary map of values that, when changed, will rotate the password by replacing it " +
+					"with a newly created one. A common use case is a timestamp which is updated on a schedule.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
 			},
 			"public_id": schema.StringAttribute{
 				Computed:    true,
@@ -92,9 +140,53 @@ func (r *databaseBranchPasswordResource) Schema(_ context.Context, _ resource.Sc
 				Description: "The username of the database branch password.",
 			},
 			"plaintext": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				Description: "The plaintext password of the database branch password. The plaintext password is only " +
+					"returned by Planetscale when the password is created, therefore it is empty for imported passwords.",
+			},
+			"hostname": schema.StringAttribute{
 				Computed:    true,
-				Sensitive:   true,
-				Description: "The plaintext password of the database branch password.",
+				Description: "The hostname to connect to the database branch with this password, returned by the " +
+					"Planetscale API as access_host_url.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the database branch password was created.",
+			},
+			"connection_strings": schema.SingleNestedAttribute{
+				Computed:  true,
+				Sensitive: true,
+				Description: "Ready-made connection strings for common drivers and frameworks, all requiring a verified " +
+					"TLS connection. Like the plaintext password, they are empty for imported passwords.",
+				Attributes: map[string]schema.Attribute{
+					"go": schema.StringAttribute{
+						Computed:    true,
+						Description: "DSN for the go-sql-driver/mysql driver.",
+					},
+					"mysql_url": schema.StringAttribute{
+						Computed:    true,
+						Description: "Generic mysql:// URL, e.g. for the mysql CLI or mysql2 in Node.js.",
+					},
+					"prisma": schema.StringAttribute{
+						Computed:    true,
+						Description: "URL for the Prisma DATABASE_URL.",
+					},
+					"rails": schema.StringAttribute{
+						Computed: true,
+						Description: "URL for the Rails DATABASE_URL using the mysql2 adapter. It expects the system CA " +
+							"bundle at /etc/ssl/certs/ca-certificates.crt.",
+					},
+					"jdbc": schema.StringAttribute{
+						Computed:    true,
+						Description: "JDBC URL for MySQL Connector/J.",
+					},
+					"php_pdo": schema.StringAttribute{
+						Computed: true,
+						Description: "DSN for PHP PDO. PDO does not accept credentials in the DSN, pass the username " +
+							"and plaintext password separately and set PDO::MYSQL_ATTR_SSL_CA to the system CA bundle.",
+					},
+				},
 			},
 		},
 	}
@@ -127,9 +219,18 @@ func (r *databaseBranchPasswordResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	plan.Role = types.StringValue(databaseBranchPassword.Role)
 	plan.PublicID = types.StringValue(databaseBranchPassword.PublicID)
 	plan.Username = types.StringValue(databaseBranchPassword.Username)
 	plan.Plaintext = types.StringValue(databaseBranchPassword.PlainText)
+	plan.Hostname = types.StringValue(databaseBranchPassword.Hostname)
+	plan.CreatedAt = types.StringValue(databaseBranchPassword.CreatedAt.String())
+	plan.ConnectionStrings = newDatabaseBranchPasswordConnectionStrings(
+		databaseBranchPassword.Username,
+		databaseBranchPassword.PlainText,
+		databaseBranchPassword.Hostname,
+		plan.Database.ValueString(),
+	)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -166,8 +267,31 @@ func (r *databaseBranchPasswordResource) Read(ctx context.Context, req resource.
 		return
 	}
 
+	// A deleted password has to be created again
+	if !databaseBranchPassword.DeletedAt.IsZero() {
+		tflog.Warn(ctx, "database branch password has been deleted, removing it from state", map[string]interface{}{
+			"public_id": state.PublicID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	// Overwrite items with refreshed state
+	state.Name = types.StringValue(databaseBranchPassword.Name)
+	state.Role = types.StringValue(databaseBranchPassword.Role)
 	state.Username = types.StringValue(databaseBranchPassword.Username)
+	state.Hostname = types.StringValue(databaseBranchPassword.Hostname)
+	state.CreatedAt = types.StringValue(databaseBranchPassword.CreatedAt.String())
+
+	// The plaintext password is only known when the password was created by Terraform
+	if !state.Plaintext.IsNull() && state.Plaintext.ValueString() != "" {
+		state.ConnectionStrings = newDatabaseBranchPasswordConnectionStrings(
+			databaseBranchPassword.Username,
+			state.Plaintext.ValueString(),
+			databaseBranchPassword.Hostname,
+			state.Database.ValueString(),
+		)
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -179,7 +303,8 @@ func (r *databaseBranchPasswordResource) Read(ctx context.Context, req resource.
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *databaseBranchPasswordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// not supported by the golang sdk (https://github.com/planetscale/planetscale-go) yet
+	// not supported by the golang sdk (https://github.com/planetscale/planetscale-go) yet, therefore every
+	// configurable attribute requires replacement
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -225,6 +350,40 @@ func (r *databaseBranchPasswordResource) Configure(_ context.Context, req resour
 	r.client = req.ProviderData.(*planetscale.Client)
 }
 
+// newDatabaseBranchPasswordConnectionStrings builds the connection strings of common drivers and frameworks for
+// a database branch password. Planetscale only accepts TLS connections, therefore every connection string verifies
+// the server certificate and hostname.
+func newDatabaseBranchPasswordConnectionStrings(username, plaintext, hostname, database string) *databaseBranchPasswordConnectionStringsModel {
+	mysqlURL := func(scheme string, query url.Values) string {
+		u := url.URL{
+			Scheme:   scheme,
+			User:     url.UserPassword(username, plaintext),
+			Host:     hostname,
+			Path:     "/" + database,
+			RawQuery: query.Encode(),
+		}
+		return u.String()
+	}
+
+	jdbcQuery := url.Values{}
+	jdbcQuery.Set("sslMode", "VERIFY_IDENTITY")
+	jdbcQuery.Set("user", username)
+	jdbcQuery.Set("password", plaintext)
+
+	return &databaseBranchPasswordConnectionStringsModel{
+		Go: types.StringValue(fmt.Sprintf("%s:%s@tcp(%s)/%s?tls=true&interpolateParams=true",
+			username, plaintext, hostname, database)),
+		MySQLURL: types.StringValue(mysqlURL("mysql", url.Values{"ssl-mode": {"VERIFY_IDENTITY"}})),
+		Prisma:   types.StringValue(mysqlURL("mysql", url.Values{"sslaccept": {"strict"}})),
+		Rails: types.StringValue(mysqlURL("mysql2", url.Values{
+			"ssl_mode": {"verify_identity"},
+			"sslca":    {"/etc/ssl/certs/ca-certificates.crt"},
+		})),
+		JDBC:   types.StringValue(fmt.Sprintf("jdbc:mysql://%s/%s?%s", hostname, database, jdbcQuery.Encode())),
+		PHPPDO: types.StringValue(fmt.Sprintf("mysql:host=%s;dbname=%s", hostname, database)),
+	}
+}
+
 func splitDatabaseBranchPasswordResourceID(id string) (teamID, _id string, branchName string, passwordID string, ok bool) {
 	attributes := strings.Split(id, "/")
 	requiredAttributesLength := 4
@@ -272,16 +431,29 @@ func (r *databaseBranchPasswordResource) ImportState(ctx context.Context, req re
 	})
 
 	diags := resp.State.Set(ctx, &databaseBranchPasswordResourceModel{
-		Name:         types.StringValue(out.Name),
-		Branch:       types.StringValue(out.Branch.Name),
-		Database:     types.StringValue(databaseName),
-		Organization: types.StringValue(organizationName),
-		Role:         types.StringValue(out.Role),
-		PublicID:     types.StringValue(out.PublicID),
-		Username:     types.StringValue(out.Username),
+		Name:             types.StringValue(out.Name),
+		Branch:           types.StringValue(out.Branch.Name),
+		Database:         types.StringValue(databaseName),
+		Organization:     types.StringValue(organizationName),
+		Role:             types.StringValue(out.Role),
+		PublicID:         types.StringValue(out.PublicID),
+		Username:         types.StringValue(out.Username),
+		Plaintext:        types.StringNull(),
+		Hostname:         types.StringValue(out.Hostname),
+		CreatedAt:        types.StringValue(out.CreatedAt.String()),
+		RotationTriggers: types.MapNull(types.StringType),
 	})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// The plaintext password is only returned when a password is created, make sure this does not go unnoticed
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("plaintext"),
+		"Plaintext password cannot be imported",
+		"Planetscale only returns the plaintext of a database branch password when the password is created, "+
+			"therefore the plaintext and connection_strings attributes of the imported password "+out.PublicID+" are "+
+			"empty. Values depending on them, such as outputs, will be empty as well. Rotate the password to get a new plaintext password.",
+	)
 }
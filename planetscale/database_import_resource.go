@@ -0,0 +1,717 @@
+package planetscale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/planetscale/planetscale-go/planetscale"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+const (
+	// dataImportPollInterval is the interval between two consecutive data import state checks.
+	dataImportPollInterval = 30 * time.Second
+	// dataImportWaitTimeout is the maximum time to wait for a data import to reach a state. Copying the data of a
+	// large database can take hours.
+	dataImportWaitTimeout = 24 * time.Hour
+
+	// dataImportStateReplica is the state of a data import once the data has been copied and Planetscale runs as a
+	// replica of the external database.
+	dataImportStateReplica = "switch_traffic_workflow_pending"
+	// dataImportStatePrimary is the state of a data import once Planetscale runs as the primary and the external
+	// database as its replica.
+	dataImportStatePrimary = "cleanup_workflow_pending"
+	// dataImportStateReady is the state of a data import once the external database has been detached.
+	dataImportStateReady = "ready"
+)
+
+var (
+	_ resource.Resource              = &databaseImportResource{}
+	_ resource.ResourceWithConfigure = &databaseImportResource{}
+)
+
+type databaseImportResourceModel struct {
+	Organization           types.String              `tfsdk:"organization"`
+	Database               types.String              `tfsdk:"database"`
+	Plan                   types.String              `tfsdk:"plan"`
+	Source                 databaseImportSourceModel `tfsdk:"source"`
+	Mode                   types.String              `tfsdk:"mode"`
+	DetachExternalDatabase types.Bool                `tfsdk:"detach_external_database"`
+	ID                     types.String              `tfsdk:"id"`
+	State                  types.String              `tfsdk:"state"`
+	ImportCheckErrors      types.String              `tfsdk:"import_check_errors"`
+	StartedAt              types.String              `tfsdk:"started_at"`
+	FinishedAt             types.String              `tfsdk:"finished_at"`
+}
+
+type databaseImportSourceModel struct {
+	Hostname types.String `tfsdk:"hostname"`
+	Port     types.Int64  `tfsdk:"port"`
+	Database types.String `tfsdk:"database"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	SSLMode  types.String `tfsdk:"ssl_mode"`
+}
+
+// NewDatabaseImportResource is a helper function to simplify the provider implementation.
+func NewDatabaseImportResource() resource.Resource {
+	return &databaseImportResource{}
+}
+
+// databaseImportResource is the resource implementation.
+type databaseImportResource struct {
+	client *planetscale.Client
+}
+
+// Metadata returns the resource type name.
+func (r *databaseImportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_import"
+}
+
+// Schema defines the schema for the resource.
+func (r *databaseImportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Imports an external MySQL database into a new Planetscale database. The connection to the " +
+			"external database is tested first, then the data is copied and Planetscale runs as a replica of the " +
+			"external database until it is made the primary and the external database is detached. Destroying this " +
+			"resource before Planetscale is made the primary cancels the import and deletes the Planetscale " +
+			"database. More info: https://planetscale.com/docs/imports/database-imports",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the organization to import the database into.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Planetscale database to create for the import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"plan": schema.StringAttribute{
+				Optional: true,
+				Description: "The billing plan of the Planetscale database. Defaults to the plan suggested by " +
+					"Planetscale for the size of the external database.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "The connection to the external MySQL database to import.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"hostname": schema.StringAttribute{
+						Required:    true,
+						Description: "The hostname of the external database server.",
+					},
+					"port": schema.Int64Attribute{
+						Optional:    true,
+						Description: "The port of the external database server. Defaults to 3306.",
+						Validators: []validator.Int64{
+							int64validator.Between(1, 65535),
+						},
+					},
+					"database": schema.StringAttribute{
+						Required:    true,
+						Description: "The name of the database to import from the external database server.",
+					},
+					"username": schema.StringAttribute{
+						Required:    true,
+						Description: "The username to connect to the external database server with.",
+					},
+					"password": schema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "The password to connect to the external database server with.",
+					},
+					"ssl_mode": schema.StringAttribute{
+						Optional: true,
+						Description: "The SSL verification mode of the connection to the external database server. " +
+							"Defaults to required. Supported values: disabled, preferred, required, verify_ca, " +
+							"verify_identity.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								"disabled",
+								"preferred",
+								"required",
+								"verify_ca",
+								"verify_identity",
+							),
+						},
+					},
+				},
+			},
+			"mode": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "Whether Planetscale runs as a replica of the external database or as the primary, with " +
+					"the external database as its replica. Defaults to replica. Supported values: replica, primary.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"replica",
+						"primary",
+					),
+				},
+			},
+			"detach_external_database": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "Whether to detach the external database and finish the import. Requires mode to be " +
+					"primary. Once detached, the import cannot be changed anymore. Defaults to false.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the data import.",
+			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The state of the data import, e.g. data_copy_pending or ready.",
+			},
+			"import_check_errors": schema.StringAttribute{
+				Computed:    true,
+				Description: "The errors found while checking the external database, if any.",
+			},
+			"started_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the data import was started.",
+			},
+			"finished_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the data import finished.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *databaseImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan databaseImportResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Mode.IsUnknown() || plan.Mode.IsNull() {
+		plan.Mode = types.StringValue("replica")
+	}
+	if plan.DetachExternalDatabase.IsUnknown() || plan.DetachExternalDatabase.IsNull() {
+		plan.DetachExternalDatabase = types.BoolValue(false)
+	}
+	if plan.DetachExternalDatabase.ValueBool() && plan.Mode.ValueString() != "primary" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("detach_external_database"),
+			"Invalid database import configuration",
+			"The external database can only be detached once Planetscale is the primary, set mode to primary.",
+		)
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "organization", plan.Organization.ValueString())
+	ctx = tflog.SetField(ctx, "database", plan.Database.ValueString())
+
+	source := dataImportSource(plan.Source)
+
+	// Test the connection and the compatibility of the external database first
+	tflog.Info(ctx, "testing data import source")
+	testResp, err := r.client.DataImports.TestDataImportSource(ctx, &planetscale.TestDataImportSourceRequest{
+		Organization: plan.Organization.ValueString(),
+		Database:     plan.Database.ValueString(),
+		Connection:   source,
+	})
+
+	// The test also succeeds when the external database is too large for the free plan, which is only a problem
+	// when no paid plan has been configured
+	var upgradeErr planetscale.UserShouldUpgradePlanError
+	if errors.As(err, &upgradeErr) {
+		if plan.Plan.IsNull() || plan.Plan.ValueString() == planetscale.HobbyPlan.String() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("plan"),
+				"Database import requires a paid plan",
+				upgradeErr.Error()+" Set plan to a paid plan, e.g. scaler.",
+			)
+			return
+		}
+		err = nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error testing database import source",
+			"Could not test the connection to the external database, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if !testResp.CanConnect {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Error testing database import source",
+			"Planetscale cannot connect to the external database: "+testResp.ConnectError,
+		)
+		return
+	}
+	if len(testResp.Errors) > 0 {
+		var lintErrors []string
+		for _, lintError := range testResp.Errors {
+			lintErrors = append(lintErrors, fmt.Sprintf("%s.%s: %s (%s)",
+				lintError.Keyspace, lintError.Table, lintError.ErrorDescription, lintError.DocsUrl))
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Error testing database import source",
+			"The external database is not compatible with Planetscale:\n"+strings.Join(lintErrors, "\n"),
+		)
+		return
+	}
+
+	billingPlan := plan.Plan.ValueString()
+	if plan.Plan.IsNull() {
+		billingPlan = testResp.SuggestedBillingPlan.String()
+	}
+
+	// create resource on Planetscale
+	tflog.Info(ctx, "starting data import", map[string]interface{}{"plan": billingPlan})
+	dataImport, err := r.client.DataImports.StartDataImport(ctx, &planetscale.StartDataImportRequest{
+		Organization: plan.Organization.ValueString(),
+		Database:     plan.Database.ValueString(),
+		Connection:   source,
+		Plan:         billingPlan,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error starting database import",
+			"Could not start data import, unexpected error: "+err.Error()+". Make sure the database name is "+
+				"unique and that you have the correct permissions.",
+		)
+		return
+	}
+
+	// The import has created the Planetscale database, therefore the state is saved after every phase so that a
+	// failing or interrupted phase leaves a tainted resource instead of an untracked database.
+	mode := plan.Mode.ValueString()
+	detachExternalDatabase := plan.DetachExternalDatabase.ValueBool()
+	plan.Mode = types.StringValue("replica")
+	plan.DetachExternalDatabase = types.BoolValue(false)
+	setDataImportState(&plan, dataImport)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dataImport, err = waitForDataImportState(ctx, r.client, plan.Organization.ValueString(), plan.Database.ValueString(), dataImportStateReplica)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for database import",
+			"Could not copy the data of the external database, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	setDataImportState(&plan, dataImport)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if mode == "primary" {
+		dataImport, err = r.makePrimary(ctx, plan.Organization.ValueString(), plan.Database.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error making Planetscale the primary",
+				"Could not make Planetscale the primary of the import, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		setDataImportState(&plan, dataImport)
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if detachExternalDatabase {
+		dataImport, err = r.detach(ctx, plan.Organization.ValueString(), plan.Database.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error detaching external database",
+				"Could not detach the external database from the import, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		setDataImportState(&plan, dataImport)
+
+		// Set state to fully populated data
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *databaseImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state databaseImportResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed data import status from Planetscale
+	dataImport, err := getDataImportStatus(ctx, r.client, state.Organization.ValueString(), state.Database.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Planetscale database import",
+			"Could not read status of Planetscale database import "+state.Database.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if dataImport.DeletedAt != nil {
+		tflog.Warn(ctx, "database import has been cancelled, removing it from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	setDataImportState(&state, dataImport)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *databaseImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and current state
+	var plan, state databaseImportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Attributes which are not configured keep their current value
+	if plan.Mode.IsUnknown() || plan.Mode.IsNull() {
+		plan.Mode = state.Mode
+	}
+	if plan.DetachExternalDatabase.IsUnknown() || plan.DetachExternalDatabase.IsNull() {
+		plan.DetachExternalDatabase = state.DetachExternalDatabase
+	}
+
+	if state.DetachExternalDatabase.ValueBool() &&
+		(!plan.DetachExternalDatabase.ValueBool() || plan.Mode.ValueString() != "primary") {
+		resp.Diagnostics.AddError(
+			"Invalid database import configuration",
+			"The external database has already been detached, therefore the import cannot be changed anymore.",
+		)
+		return
+	}
+	if plan.DetachExternalDatabase.ValueBool() && plan.Mode.ValueString() != "primary" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("detach_external_database"),
+			"Invalid database import configuration",
+			"The external database can only be detached once Planetscale is the primary, set mode to primary.",
+		)
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "organization", plan.Organization.ValueString())
+	ctx = tflog.SetField(ctx, "database", plan.Database.ValueString())
+
+	var err error
+	if plan.Mode.ValueString() != state.Mode.ValueString() {
+		if plan.Mode.ValueString() == "primary" {
+			_, err = r.makePrimary(ctx, plan.Organization.ValueString(), plan.Database.ValueString())
+		} else {
+			_, err = r.makeReplica(ctx, plan.Organization.ValueString(), plan.Database.ValueString())
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error switching database import mode",
+				"Could not make Planetscale the "+plan.Mode.ValueString()+" of the import, unexpected error: "+
+					err.Error(),
+			)
+			return
+		}
+	}
+
+	if plan.DetachExternalDatabase.ValueBool() && !state.DetachExternalDatabase.ValueBool() {
+		_, err = r.detach(ctx, plan.Organization.ValueString(), plan.Database.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error detaching external database",
+				"Could not detach the external database from the import, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Refresh the computed attributes
+	dataImport, err := getDataImportStatus(ctx, r.client, plan.Organization.ValueString(), plan.Database.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Planetscale database import",
+			"Could not read status of Planetscale database import "+plan.Database.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	setDataImportState(&plan, dataImport)
+
+	// Set state to fully populated data
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *databaseImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state databaseImportResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "organization", state.Organization.ValueString())
+	ctx = tflog.SetField(ctx, "database", state.Database.ValueString())
+
+	// The saved mode can be outdated after a failed or interrupted switch, therefore the live state of the import
+	// decides whether it can be cancelled
+	dataImport, err := getDataImportStatus(ctx, r.client, state.Organization.ValueString(), state.Database.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error cancelling Planetscale database import",
+			"Could not read status of Planetscale database import "+state.Database.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	switch dataImport.State {
+	case "prepare_data_copy_pending", "prepare_data_copy_error", "data_copy_pending", "data_copy_error",
+		dataImportStateReplica:
+		// Cancelling deletes the Planetscale database, which is only safe as long as the external database is the
+		// primary
+	case dataImportStateReady:
+		// A finished import leaves a regular Planetscale database behind, which is not managed by this resource
+		tflog.Debug(ctx, "database import has finished, removing it from state only")
+		return
+	default:
+		resp.Diagnostics.AddError(
+			"Error cancelling Planetscale database import",
+			"The database import is in state "+dataImport.State+", in which Planetscale is or may be becoming the "+
+				"primary. Cancelling it would delete the database that may be receiving the writes. Set mode to "+
+				"replica before destroying the import or detach the external database to finish it.",
+		)
+		return
+	}
+
+	err = r.client.DataImports.CancelDataImport(ctx, &planetscale.CancelDataImportRequest{
+		Organization: state.Organization.ValueString(),
+		Database:     state.Database.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error cancelling Planetscale database import",
+			"Could not cancel database import, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "cancelled Planetscale database import")
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *databaseImportResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*planetscale.Client)
+}
+
+// makePrimary makes Planetscale the primary of the import and waits for the switch to finish.
+func (r *databaseImportResource) makePrimary(ctx context.Context, organization, database string) (dataImport *planetscale.DataImport, err error) {
+	defer recoverDataImportState(&err)
+
+	tflog.Info(ctx, "making Planetscale the primary of the data import")
+	_, err = r.client.DataImports.MakePlanetScalePrimary(ctx, &planetscale.MakePlanetScalePrimaryRequest{
+		Organization: organization,
+		Database:     database,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return waitForDataImportState(ctx, r.client, organization, database, dataImportStatePrimary)
+}
+
+// makeReplica makes Planetscale a replica of the external database again and waits for the switch to finish.
+func (r *databaseImportResource) makeReplica(ctx context.Context, organization, database string) (dataImport *planetscale.DataImport, err error) {
+	defer recoverDataImportState(&err)
+
+	tflog.Info(ctx, "making Planetscale a replica of the data import")
+	_, err = r.client.DataImports.MakePlanetScaleReplica(ctx, &planetscale.MakePlanetScaleReplicaRequest{
+		Organization: organization,
+		Database:     database,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return waitForDataImportState(ctx, r.client, organization, database, dataImportStateReplica)
+}
+
+// detach detaches the external database from the import and waits for the import to be ready.
+func (r *databaseImportResource) detach(ctx context.Context, organization, database string) (dataImport *planetscale.DataImport, err error) {
+	defer recoverDataImportState(&err)
+
+	tflog.Info(ctx, "detaching external database from the data import")
+	_, err = r.client.DataImports.DetachExternalDatabase(ctx, &planetscale.DetachExternalDatabaseRequest{
+		Organization: organization,
+		Database:     database,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return waitForDataImportState(ctx, r.client, organization, database, dataImportStateReady)
+}
+
+// dataImportSource converts the configured source into the connection of a data import request.
+func dataImportSource(source databaseImportSourceModel) planetscale.DataImportSource {
+	port := 3306
+	if !source.Port.IsNull() {
+		port = int(source.Port.ValueInt64())
+	}
+
+	sslMode := planetscale.SSLModeRequired
+	switch source.SSLMode.ValueString() {
+	case "disabled":
+		sslMode = planetscale.SSLModeDisabled
+	case "preferred":
+		sslMode = planetscale.SSLModePreferred
+	case "verify_ca":
+		sslMode = planetscale.SSLModeVerifyCA
+	case "verify_identity":
+		sslMode = planetscale.SSLModeVerifyIdentity
+	}
+
+	return planetscale.DataImportSource{
+		HostName:            source.Hostname.ValueString(),
+		Port:                port,
+		Database:            source.Database.ValueString(),
+		UserName:            source.Username.ValueString(),
+		Password:            source.Password.ValueString(),
+		SSLVerificationMode: sslMode,
+	}
+}
+
+// setDataImportState sets the attributes of the model derived from the given data import.
+func setDataImportState(model *databaseImportResourceModel, dataImport *planetscale.DataImport) {
+	model.ID = types.StringValue(dataImport.ID)
+	model.State = types.StringValue(dataImport.State)
+	model.ImportCheckErrors = types.StringValue(dataImport.Errors)
+	model.StartedAt = optionalTimeValue(dataImport.StartedAt)
+	model.FinishedAt = optionalTimeValue(dataImport.FinishedAt)
+
+	// The mode is only known once the data has been copied
+	switch dataImport.State {
+	case dataImportStateReplica:
+		model.Mode = types.StringValue("replica")
+		model.DetachExternalDatabase = types.BoolValue(false)
+	case dataImportStatePrimary:
+		model.Mode = types.StringValue("primary")
+		model.DetachExternalDatabase = types.BoolValue(false)
+	case dataImportStateReady:
+		model.Mode = types.StringValue("primary")
+		model.DetachExternalDatabase = types.BoolValue(true)
+	}
+}
+
+// getDataImportStatus gets the status of the data import of the given database.
+func getDataImportStatus(ctx context.Context, client *planetscale.Client, organization, database string) (dataImport *planetscale.DataImport, err error) {
+	defer recoverDataImportState(&err)
+
+	return client.DataImports.GetDataImportStatus(ctx, &planetscale.GetImportStatusRequest{
+		Organization: organization,
+		Database:     database,
+	})
+}
+
+// waitForDataImportState polls the data import of the given database until it reaches one of the target states.
+func waitForDataImportState(ctx context.Context, client *planetscale.Client, organization, database string, targets ...string) (*planetscale.DataImport, error) {
+	ctx, cancel := context.WithTimeout(ctx, dataImportWaitTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(dataImportPollInterval)
+	defer ticker.Stop()
+
+	for {
+		dataImport, err := getDataImportStatus(ctx, client, organization, database)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, target := range targets {
+			if dataImport.State == target {
+				return dataImport, nil
+			}
+		}
+
+		switch dataImport.State {
+		case "prepare_data_copy_error", "data_copy_error", "switch_traffic_workflow_error",
+			"reverse_traffic_workflow_error", "cleanup_workflow_error":
+			return dataImport, fmt.Errorf("data import of database %s ended up in state %s: %s", database,
+				dataImport.State, dataImport.Errors)
+		}
+
+		tflog.Debug(ctx, "waiting for data import state", map[string]interface{}{
+			"state":   dataImport.State,
+			"targets": targets,
+		})
+
+		select {
+		case <-ctx.Done():
+			return dataImport, fmt.Errorf("timed out waiting for data import of database %s to reach state %v, last "+
+				"state was %s", database, targets, dataImport.State)
+		case <-ticker.C:
+		}
+	}
+}
+
+// recoverDataImportState turns the panic of the Planetscale Golang SDK on unknown data import states into an error.
+func recoverDataImportState(err *error) {
+	if r := recover(); r != nil {
+		*err = fmt.Errorf("%v", r)
+	}
+}
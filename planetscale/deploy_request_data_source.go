@@ -0,0 +1,313 @@
+package planetscale
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/planetscale/planetscale-go/planetscale"
+)
+
+// deployRequestDataSourceModel maps the data source schema data.
+type deployRequestDataSourceModel struct {
+	Organization    types.String     `tfsdk:"organization"`
+	Database        types.String     `tfsdk:"database"`
+	Number          types.Int64      `tfsdk:"number"`
+	ID              types.String     `tfsdk:"id"`
+	Branch          types.String     `tfsdk:"branch"`
+	IntoBranch      types.String     `tfsdk:"into_branch"`
+	Notes           types.String     `tfsdk:"notes"`
+	State           types.String     `tfsdk:"state"`
+	DeploymentState types.String     `tfsdk:"deployment_state"`
+	Approved        types.Bool       `tfsdk:"approved"`
+	HTMLURL         types.String     `tfsdk:"html_url"`
+	CreatedAt       types.String     `tfsdk:"created_at"`
+	UpdatedAt       types.String     `tfsdk:"updated_at"`
+	ClosedAt        types.String     `tfsdk:"closed_at"`
+	DeployedAt      types.String     `tfsdk:"deployed_at"`
+	Deployment      *deploymentModel `tfsdk:"deployment"`
+}
+
+// deploymentModel maps the deployment details of a deploy request.
+type deploymentModel struct {
+	ID            types.String               `tfsdk:"id"`
+	State         types.String               `tfsdk:"state"`
+	Deployable    types.Bool                 `tfsdk:"deployable"`
+	QueuePosition types.Int64                `tfsdk:"queue_position"`
+	LintErrors    []deploymentLintErrorModel `tfsdk:"lint_errors"`
+	CreatedAt     types.String               `tfsdk:"created_at"`
+	UpdatedAt     types.String               `tfsdk:"updated_at"`
+	QueuedAt      types.String               `tfsdk:"queued_at"`
+	StartedAt     types.String               `tfsdk:"started_at"`
+	FinishedAt    types.String               `tfsdk:"finished_at"`
+}
+
+// deploymentLintErrorModel maps a lint error of a deployment.
+type deploymentLintErrorModel struct {
+	LintError        types.String `tfsdk:"lint_error"`
+	Keyspace         types.String `tfsdk:"keyspace"`
+	Table            types.String `tfsdk:"table"`
+	SubjectType      types.String `tfsdk:"subject_type"`
+	ErrorDescription types.String `tfsdk:"error_description"`
+	DocsURL          types.String `tfsdk:"docs_url"`
+}
+
+func NewDeployRequestDataSource() datasource.DataSource {
+	return &deployRequestDataSource{}
+}
+
+type deployRequestDataSource struct {
+	client *planetscale.Client
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &deployRequestDataSource{}
+	_ datasource.DataSourceWithConfigure = &deployRequestDataSource{}
+)
+
+func (d *deployRequestDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deploy_request"
+}
+
+// Schema defines the schema for the data source.
+func (d *deployRequestDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A single deploy request of the given database, including the details of its deployment such as " +
+			"lint errors, queue position and the time of each deployment phase. The Planetscale Golang SDK does not " +
+			"return the deploy operations per table and keyspace nor whether the deployment is eligible for instant " +
+			"DDL, therefore they are not available.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the organization the database belongs to.",
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the database the deploy request belongs to.",
+			},
+			"number": schema.Int64Attribute{
+				Required:    true,
+				Description: "The number of the deploy request to get.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the deploy request.",
+			},
+			"branch": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the branch to start the deploy request onto.",
+			},
+			"into_branch": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the branch to merge the deploy request into.",
+			},
+			"notes": schema.StringAttribute{
+				Computed:    true,
+				Description: "The notes for the deploy request.",
+			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The state of the deploy request.",
+			},
+			"deployment_state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The deployment state of the deploy request.",
+			},
+			"approved": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the deploy request has been approved.",
+			},
+			"html_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the deploy request.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the deploy request was created.",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the deploy request was last updated.",
+			},
+			"closed_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the deploy request was closed, if it has been closed.",
+			},
+			"deployed_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the deploy request was deployed, if it has been deployed.",
+			},
+			"deployment": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The deployment of the deploy request. Empty if the deploy request has no deployment yet.",
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The ID of the deployment.",
+					},
+					"state": schema.StringAttribute{
+						Computed:    true,
+						Description: "The state of the deployment.",
+					},
+					"deployable": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the deployment can be deployed.",
+					},
+					"queue_position": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The number of deployments queued ahead of this deployment. Empty when the " +
+							"deployment is not queued.",
+					},
+					"lint_errors": schema.ListNestedAttribute{
+						Computed:    true,
+						Description: "The lint errors preventing the deployment.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"lint_error": schema.StringAttribute{
+									Computed:    true,
+									Description: "The lint error code.",
+								},
+								"keyspace": schema.StringAttribute{
+									Computed:    true,
+									Description: "The keyspace the lint error applies to.",
+								},
+								"table": schema.StringAttribute{
+									Computed:    true,
+									Description: "The table the lint error applies to.",
+								},
+								"subject_type": schema.StringAttribute{
+									Computed:    true,
+									Description: "The type of the subject the lint error applies to.",
+								},
+								"error_description": schema.StringAttribute{
+									Computed:    true,
+									Description: "The description of the lint error.",
+								},
+								"docs_url": schema.StringAttribute{
+									Computed:    true,
+									Description: "The URL of the documentation for the lint error.",
+								},
+							},
+						},
+					},
+					"created_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The time the deployment was created.",
+					},
+					"updated_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The time the deployment was last updated.",
+					},
+					"queued_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The time the deployment was queued.",
+					},
+					"started_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The time the deployment started.",
+					},
+					"finished_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The time the deployment finished.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *deployRequestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state deployRequestDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	ctx = tflog.SetField(ctx, "organization", state.Organization.ValueString())
+	ctx = tflog.SetField(ctx, "database", state.Database.ValueString())
+	ctx = tflog.SetField(ctx, "number", state.Number.ValueInt64())
+
+	tflog.Info(ctx, "requesting deploy request info")
+
+	deployRequest, err := d.client.DeployRequests.Get(ctx, &planetscale.GetDeployRequestRequest{
+		Organization: state.Organization.ValueString(),
+		Database:     state.Database.ValueString(),
+		Number:       uint64(state.Number.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read deploy request. Make sure your credentials are correct and you have access "+
+				"to the organization, or that you have the correct permissions for this database.",
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(deployRequest.ID)
+	state.Branch = types.StringValue(deployRequest.Branch)
+	state.IntoBranch = types.StringValue(deployRequest.IntoBranch)
+	state.Notes = types.StringValue(deployRequest.Notes)
+	state.State = types.StringValue(deployRequest.State)
+	state.DeploymentState = types.StringValue(deployRequest.DeploymentState)
+	state.Approved = types.BoolValue(deployRequest.Approved)
+	state.HTMLURL = types.StringValue(deployRequest.HtmlURL)
+	state.CreatedAt = types.StringValue(deployRequest.CreatedAt.String())
+	state.UpdatedAt = types.StringValue(deployRequest.UpdatedAt.String())
+	state.ClosedAt = optionalTimeValue(deployRequest.ClosedAt)
+	state.DeployedAt = optionalTimeValue(deployRequest.DeployedAt)
+
+	if deployment := deployRequest.Deployment; deployment != nil {
+		state.Deployment = &deploymentModel{
+			ID:            types.StringValue(deployment.ID),
+			State:         types.StringValue(deployment.State),
+			Deployable:    types.BoolValue(deployment.Deployable),
+			QueuePosition: types.Int64Null(),
+			LintErrors:    []deploymentLintErrorModel{},
+			CreatedAt:     types.StringValue(deployment.CreatedAt.String()),
+			UpdatedAt:     types.StringValue(deployment.UpdatedAt.String()),
+			QueuedAt:      optionalTimeValue(deployment.QueuedAt),
+			StartedAt:     optionalTimeValue(deployment.StartedAt),
+			FinishedAt:    optionalTimeValue(deployment.FinishedAt),
+		}
+		// The preceding deployments are only meaningful while the deployment waits in the deploy queue
+		if deployment.State == "queued" {
+			state.Deployment.QueuePosition = types.Int64Value(int64(len(deployment.PrecedingDeployments)))
+		}
+		for _, lintError := range deployment.LintErrors {
+			state.Deployment.LintErrors = append(state.Deployment.LintErrors, deploymentLintErrorModel{
+				LintError:        types.StringValue(lintError.LintError),
+				Keyspace:         types.StringValue(lintError.Keyspace),
+				Table:            types.StringValue(lintError.Table),
+				SubjectType:      types.StringValue(lintError.SubjectType),
+				ErrorDescription: types.StringValue(lintError.ErrorDescription),
+				DocsURL:          types.StringValue(lintError.DocsUrl),
+			})
+		}
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *deployRequestDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*planetscale.Client)
+}
+
+// optionalTimeValue returns the string value of the given time, or a null value if the time is not set.
+func optionalTimeValue(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.String())
+}
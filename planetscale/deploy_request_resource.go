@@ -2,6 +2,17 @@ package planetscale
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/planetscale/planetscale-go/planetscale"
@@ -11,10 +22,20 @@ import (
 )
 
 var (
-	_ resource.Resource              = &deployRequestResource{}
-	_ resource.ResourceWithConfigure = &deployRequestResource{}
+	_ resource.Resource                = &deployRequestResource{}
+	_ resource.ResourceWithConfigure   = &deployRequestResource{}
+	_ resource.ResourceWithImportState = &deployRequestResource{}
+	_ resource.ResourceWithModifyPlan  = &deployRequestResource{}
+)
+
+const (
+	// deployRequestPollInterval is the interval between two consecutive deploy request state checks.
+	deployRequestPollInterval = 5 * time.Second
+	// deployRequestWaitTimeout is the maximum time to wait for a deploy request to reach a deployment state.
+	deployRequestWaitTimeout = 30 * time.Minute
 )
 
+// deployRequestModel maps the deploy request schema data shared between the resource and data sources.
 type deployRequestModel struct {
 	Organization    types.String `tfsdk:"organization"`
 	Database        types.String `tfsdk:"database"`
@@ -31,6 +52,26 @@ type deployRequestModel struct {
 	Number          types.Int64  `tfsdk:"number"`
 }
 
+// deployRequestResourceModel maps the deploy request resource schema data.
+type deployRequestResourceModel struct {
+	Organization       types.String `tfsdk:"organization"`
+	Database           types.String `tfsdk:"database"`
+	Branch             types.String `tfsdk:"branch"`
+	IntoBranch         types.String `tfsdk:"into_branch"`
+	Notes              types.String `tfsdk:"notes"`
+	ID                 types.String `tfsdk:"id"`
+	State              types.String `tfsdk:"state"`
+	DeploymentState    types.String `tfsdk:"deployment_state"`
+	HTMLURL            types.String `tfsdk:"html_url"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+	Approved           types.Bool   `tfsdk:"approved"`
+	Number             types.Int64  `tfsdk:"number"`
+	RevertWindowAction types.String `tfsdk:"revert_window_action"`
+	AutoApply          types.Bool   `tfsdk:"auto_apply"`
+	Apply              types.Bool   `tfsdk:"apply"`
+}
+
 // NewDeployRequestResource is a helper function to simplify the provider implementation.
 func NewDeployRequestResource() resource.Resource {
 	return &deployRequestResource{}
@@ -55,22 +96,67 @@ func (r *deployRequestResource) Schema(_ context.Context, _ resource.SchemaReque
 			"organization": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"database": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the database.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"notes": schema.StringAttribute{
 				Optional:    true,
 				Description: "The notes for the deploy request.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"branch": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the branch to start the deploy request onto.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"into_branch": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the branch to merge the deploy request into.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auto_apply": schema.BoolAttribute{
+				Optional: true,
+				Description: "Whether the schema changes are applied automatically once the deploy request has been " +
+					"deployed. When set to false, the deployment is staged until it is applied, see the apply attribute. " +
+					"The Planetscale Golang SDK does not return the auto-apply setting of a deploy request, therefore " +
+					"changes made outside of Terraform are not detected and the attribute is empty after an import.",
+			},
+			"apply": schema.BoolAttribute{
+				Optional: true,
+				Description: "Set to true to apply a deployment that has been staged because auto_apply is disabled. " +
+					"The changes are applied when the value changes to true on an existing deploy request, and the " +
+					"provider waits for the deployment to complete. As a deploy request cannot be deployed yet when it " +
+					"is created, it cannot be set to true on creation.",
+			},
+			"revert_window_action": schema.StringAttribute{
+				Optional: true,
+				Description: "The action to take on the revert window that stays open after the deploy request has " +
+					"been deployed. Setting it to revert rolls back the deployed schema changes, while skip_revert " +
+					"closes the revert window and keeps the changes. The action is performed when the value changes on " +
+					"an existing deploy request, and the provider waits for the resulting deployment state. As a deploy " +
+					"request cannot be deployed yet when it is created, only none can be set on creation. " +
+					"Supported values: revert, skip_revert, none.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"revert",
+						"skip_revert",
+						"none",
+					),
+				},
 			},
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -111,13 +197,18 @@ func (r *deployRequestResource) Schema(_ context.Context, _ resource.SchemaReque
 // Create creates the resource and sets the initial Terraform state.
 func (r *deployRequestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var plan deployRequestModel
+	var plan deployRequestResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(validateDeployRequestCreate(plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// create resource on Planetscale
 	deployRequest, err := r.client.DeployRequests.Create(ctx, &planetscale.CreateDeployRequestRequest{
 		Organization: plan.Organization.ValueString(),
@@ -135,27 +226,61 @@ func (r *deployRequestResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	plan.ID = types.StringValue(deployRequest.ID)
-	plan.Number = types.Int64Value(int64(deployRequest.Number))
-	plan.State = types.StringValue(deployRequest.State)
-	plan.DeploymentState = types.StringValue(deployRequest.DeploymentState)
-	plan.Approved = types.BoolValue(deployRequest.Approved)
-	plan.HTMLURL = types.StringValue(deployRequest.HtmlURL)
-	plan.CreatedAt = types.StringValue(deployRequest.CreatedAt.String())
-	plan.UpdatedAt = types.StringValue(deployRequest.UpdatedAt.String())
+	setDeployRequestState(&plan, deployRequest)
 
-	// Set state to fully populated data
+	// Save the deploy request before configuring it, so that it is tracked even if the configuration fails
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if !plan.AutoApply.IsNull() {
+		deployRequest, err = r.client.DeployRequests.AutoApplyDeploy(ctx, &planetscale.AutoApplyDeployRequestRequest{
+			Organization: plan.Organization.ValueString(),
+			Database:     plan.Database.ValueString(),
+			Number:       deployRequest.Number,
+			Enable:       plan.AutoApply.ValueBool(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating deploy request",
+				"Could not configure auto-apply for deploy request, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		setDeployRequestState(&plan, deployRequest)
+
+		// Set state to fully populated data
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// ModifyPlan rejects deployment actions on deploy requests which are about to be created.
+func (r *deployRequestResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only creations are checked, updates and deletions have a prior state
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan deployRequestResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateDeployRequestCreate(plan)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (r *deployRequestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state deployRequestModel
+	var state deployRequestResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -178,6 +303,10 @@ func (r *deployRequestResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	// Overwrite items with refreshed state
+	state.Branch = types.StringValue(deployRequest.Branch)
+	state.IntoBranch = types.StringValue(deployRequest.IntoBranch)
+	state.Notes = deployRequestNotesValue(deployRequest.Notes, state.Notes)
+	state.HTMLURL = types.StringValue(deployRequest.HtmlURL)
 	state.State = types.StringValue(deployRequest.State)
 	state.DeploymentState = types.StringValue(deployRequest.DeploymentState)
 	state.Approved = types.BoolValue(deployRequest.Approved)
@@ -193,13 +322,124 @@ func (r *deployRequestResource) Read(ctx context.Context, req resource.ReadReque
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *deployRequestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// do nothing as a deploy-request should not be updated
+	// Retrieve values from plan and current state
+	var plan, state deployRequestResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "organization", state.Organization.ValueString())
+	ctx = tflog.SetField(ctx, "database", state.Database.ValueString())
+	ctx = tflog.SetField(ctx, "number", state.Number.ValueInt64())
+
+	// The deploy request itself cannot be updated, only actions on its deployment can be performed.
+	organization := state.Organization.ValueString()
+	database := state.Database.ValueString()
+	number := uint64(state.Number.ValueInt64())
+
+	if !plan.AutoApply.IsNull() && !plan.AutoApply.Equal(state.AutoApply) {
+		tflog.Info(ctx, "configuring auto-apply of deploy request")
+		_, err := r.client.DeployRequests.AutoApplyDeploy(ctx, &planetscale.AutoApplyDeployRequestRequest{
+			Organization: organization,
+			Database:     database,
+			Number:       number,
+			Enable:       plan.AutoApply.ValueBool(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating deploy request",
+				"Could not configure auto-apply for deploy request, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if plan.Apply.ValueBool() && !state.Apply.ValueBool() {
+		tflog.Info(ctx, "applying staged deployment of deploy request")
+		_, err := r.client.DeployRequests.ApplyDeploy(ctx, &planetscale.ApplyDeployRequestRequest{
+			Organization: organization,
+			Database:     database,
+			Number:       number,
+		})
+		if err == nil {
+			_, err = waitForDeployRequestDeploymentState(ctx, r.client, organization, database, number, "complete_pending_revert", "complete")
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating deploy request",
+				"Could not apply the staged deployment of deploy request, unexpected error: "+err.Error()+". Make "+
+					"sure the deploy request has been deployed with auto-apply disabled and is pending cutover.",
+			)
+			return
+		}
+	}
+
+	var err error
+	if !plan.RevertWindowAction.Equal(state.RevertWindowAction) {
+		switch plan.RevertWindowAction.ValueString() {
+		case "revert":
+			tflog.Info(ctx, "reverting deployed deploy request")
+			_, err = r.client.DeployRequests.RevertDeploy(ctx, &planetscale.RevertDeployRequestRequest{
+				Organization: organization,
+				Database:     database,
+				Number:       number,
+			})
+			if err == nil {
+				_, err = waitForDeployRequestDeploymentState(ctx, r.client, organization, database, number, "complete_revert")
+			}
+		case "skip_revert":
+			tflog.Info(ctx, "skipping revert of deployed deploy request")
+			_, err = r.client.DeployRequests.SkipRevertDeploy(ctx, &planetscale.SkipRevertDeployRequestRequest{
+				Organization: organization,
+				Database:     database,
+				Number:       number,
+			})
+			if err == nil {
+				_, err = waitForDeployRequestDeploymentState(ctx, r.client, organization, database, number, "complete")
+			}
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating deploy request",
+				"Could not perform revert window action "+plan.RevertWindowAction.ValueString()+" on deploy request, "+
+					"unexpected error: "+err.Error()+". Make sure the deploy request has been deployed and its revert "+
+					"window is still open.",
+			)
+			return
+		}
+	}
+
+	// Get refreshed deploy request info from Planetscale
+	deployRequest, err := r.client.DeployRequests.Get(ctx, &planetscale.GetDeployRequestRequest{
+		Organization: organization,
+		Database:     database,
+		Number:       number,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading deploy request",
+			"Could not read info about deploy request for database "+state.Database.ValueString()+" and"+
+				" branch "+state.Branch.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	setDeployRequestState(&plan, deployRequest)
+
+	// Set state to fully populated data
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *deployRequestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state deployRequestModel
+	var state deployRequestResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -238,3 +478,166 @@ func (r *deployRequestResource) Configure(_ context.Context, req resource.Config
 
 	r.client = req.ProviderData.(*planetscale.Client)
 }
+
+func splitDeployRequestResourceID(id string) (organizationName, databaseName string, number uint64, ok bool) {
+	attributes := strings.Split(id, "/")
+	requiredAttributesLength := 3
+	if len(attributes) != requiredAttributesLength {
+		return "", "", 0, false
+	}
+	number, err := strconv.ParseUint(attributes[2], 10, 64)
+	if err != nil {
+		return "", "", 0, false
+	}
+	return attributes[0], attributes[1], number, true
+}
+
+func (r *deployRequestResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organizationName, databaseName, number, ok := splitDeployRequestResourceID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing deploy request",
+			fmt.Sprintf("Invalid input '%s' provided. should be in format \"organization_name/database_name/number\"", req.ID),
+		)
+		return
+	}
+
+	out, err := r.client.DeployRequests.Get(ctx, &planetscale.GetDeployRequestRequest{
+		Organization: organizationName,
+		Database:     databaseName,
+		Number:       number,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading deploy request",
+			fmt.Sprintf("Could not get deploy request %s %s %d, unexpected error: %v",
+				organizationName,
+				databaseName,
+				number,
+				err,
+			),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "imported deploy request", map[string]interface{}{
+		"organization": organizationName,
+		"database":     databaseName,
+		"number":       number,
+	})
+
+	diags := resp.State.Set(ctx, &deployRequestResourceModel{
+		Organization:       types.StringValue(organizationName),
+		Database:           types.StringValue(databaseName),
+		Branch:             types.StringValue(out.Branch),
+		IntoBranch:         types.StringValue(out.IntoBranch),
+		Notes:              deployRequestNotesValue(out.Notes, types.StringNull()),
+		ID:                 types.StringValue(out.ID),
+		State:              types.StringValue(out.State),
+		DeploymentState:    types.StringValue(out.DeploymentState),
+		HTMLURL:            types.StringValue(out.HtmlURL),
+		CreatedAt:          types.StringValue(out.CreatedAt.String()),
+		UpdatedAt:          types.StringValue(out.UpdatedAt.String()),
+		Approved:           types.BoolValue(out.Approved),
+		Number:             types.Int64Value(int64(out.Number)),
+		RevertWindowAction: types.StringNull(),
+		AutoApply:          types.BoolNull(),
+		Apply:              types.BoolNull(),
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// setDeployRequestState sets the computed attributes of the model from the given deploy request.
+func setDeployRequestState(model *deployRequestResourceModel, deployRequest *planetscale.DeployRequest) {
+	model.ID = types.StringValue(deployRequest.ID)
+	model.Number = types.Int64Value(int64(deployRequest.Number))
+	model.State = types.StringValue(deployRequest.State)
+	model.DeploymentState = types.StringValue(deployRequest.DeploymentState)
+	model.Approved = types.BoolValue(deployRequest.Approved)
+	model.HTMLURL = types.StringValue(deployRequest.HtmlURL)
+	model.CreatedAt = types.StringValue(deployRequest.CreatedAt.String())
+	model.UpdatedAt = types.StringValue(deployRequest.UpdatedAt.String())
+}
+
+// validateDeployRequestCreate validates the planned attributes of a deploy request which is about to be created. A new
+// deploy request has not been deployed yet, therefore no action can be performed on its deployment.
+func validateDeployRequestCreate(plan deployRequestResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !plan.RevertWindowAction.IsNull() && !plan.RevertWindowAction.IsUnknown() &&
+		plan.RevertWindowAction.ValueString() != "none" {
+		diags.AddAttributeError(
+			path.Root("revert_window_action"),
+			"Invalid deploy request configuration",
+			"The revert window action "+plan.RevertWindowAction.ValueString()+" cannot be performed on a deploy "+
+				"request which is being created. Set it after the deploy request has been deployed.",
+		)
+	}
+
+	if plan.Apply.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("apply"),
+			"Invalid deploy request configuration",
+			"A deploy request which is being created has no staged deployment to apply. Set apply to true after "+
+				"the deploy request has been deployed.",
+		)
+	}
+
+	return diags
+}
+
+// deployRequestNotesValue returns the notes of a deploy request. Planetscale returns empty notes when none have been
+// set, which are kept null unless notes were set before, so that unset notes do not show up as a difference.
+func deployRequestNotesValue(notes string, current types.String) types.String {
+	if notes == "" && current.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(notes)
+}
+
+// waitForDeployRequestDeploymentState polls the deploy request until its deployment reaches one of the given target
+// states. It returns an error if the deployment ends up in a failed state or the wait times out.
+func waitForDeployRequestDeploymentState(ctx context.Context, client *planetscale.Client, organization, database string, number uint64, targets ...string) (*planetscale.DeployRequest, error) {
+	ctx, cancel := context.WithTimeout(ctx, deployRequestWaitTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(deployRequestPollInterval)
+	defer ticker.Stop()
+
+	for {
+		deployRequest, err := client.DeployRequests.Get(ctx, &planetscale.GetDeployRequestRequest{
+			Organization: organization,
+			Database:     database,
+			Number:       number,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, target := range targets {
+			if deployRequest.DeploymentState == target {
+				return deployRequest, nil
+			}
+		}
+
+		switch deployRequest.DeploymentState {
+		case "error", "cancelled", "complete_error", "complete_revert_error":
+			return deployRequest, fmt.Errorf("deploy request %d ended up in deployment state %s", number, deployRequest.DeploymentState)
+		}
+
+		tflog.Debug(ctx, "waiting for deploy request deployment state", map[string]interface{}{
+			"deployment_state": deployRequest.DeploymentState,
+			"targets":          targets,
+		})
+
+		select {
+		case <-ctx.Done():
+			return deployRequest, fmt.Errorf("timed out waiting for deploy request %d to reach deployment state %v, last "+
+				"deployment state was %s", number, targets, deployRequest.DeploymentState)
+		case <-ticker.C:
+		}
+	}
+}
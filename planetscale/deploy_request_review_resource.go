@@ -0,0 +1,197 @@
+package planetscale
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/planetscale/planetscale-go/planetscale"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var (
+	_ resource.Resource              = &deployRequestReviewResource{}
+	_ resource.ResourceWithConfigure = &deployRequestReviewResource{}
+)
+
+type deployRequestReviewResourceModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Database     types.String `tfsdk:"database"`
+	Number       types.Int64  `tfsdk:"number"`
+	State        types.String `tfsdk:"state"`
+	Body         types.String `tfsdk:"body"`
+	ID           types.String `tfsdk:"id"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+// NewDeployRequestReviewResource is a helper function to simplify the provider implementation.
+func NewDeployRequestReviewResource() resource.Resource {
+	return &deployRequestReviewResource{}
+}
+
+// deployRequestReviewResource is the resource implementation.
+type deployRequestReviewResource struct {
+	client *planetscale.Client
+}
+
+// Metadata returns the resource type name.
+func (r *deployRequestReviewResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deploy_request_review"
+}
+
+// Schema defines the schema for the resource.
+func (r *deployRequestReviewResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A review on a Planetscale deploy request. A review either approves the deploy request or leaves a " +
+			"comment on it. Reviews cannot be edited or removed once posted, therefore any change to this resource posts " +
+			"a new review. More info: https://planetscale.com/docs/concepts/deploy-requests",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the database.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"number": schema.Int64Attribute{
+				Required:    true,
+				Description: "The number of the deploy request to review.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Required:    true,
+				Description: "The state of the review. Supported values: approved, commented.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"approved",
+						"commented",
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"body": schema.StringAttribute{
+				Optional:    true,
+				Description: "The comment text of the review.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the review.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the review was created.",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the review was last updated.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *deployRequestReviewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan deployRequestReviewResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reviewAction := planetscale.ReviewComment
+	if plan.State.ValueString() == planetscale.ReviewApprove.String() {
+		reviewAction = planetscale.ReviewApprove
+	}
+
+	// create resource on Planetscale
+	review, err := r.client.DeployRequests.CreateReview(ctx, &planetscale.ReviewDeployRequestRequest{
+		Organization: plan.Organization.ValueString(),
+		Database:     plan.Database.ValueString(),
+		Number:       uint64(plan.Number.ValueInt64()),
+		CommentText:  plan.Body.ValueString(),
+		ReviewAction: reviewAction,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating deploy request review",
+			"Could not create deploy request review, unexpected error: "+err.Error()+". Make sure you have the "+
+				"correct permissions to review deploy requests for this database. Note that a deploy request cannot "+
+				"be approved by the same identity that opened it.",
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(review.ID)
+	plan.CreatedAt = types.StringValue(review.CreatedAt.String())
+	plan.UpdatedAt = types.StringValue(review.UpdatedAt.String())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *deployRequestReviewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// reading a single review is not supported by the golang sdk (https://github.com/planetscale/planetscale-go)
+	// yet, therefore the state is kept as it was set on create
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *deployRequestReviewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// every attribute requires a replacement, therefore there is nothing to update in place
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *deployRequestReviewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state deployRequestReviewResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "organization", state.Organization.ValueString())
+	ctx = tflog.SetField(ctx, "database", state.Database.ValueString())
+	ctx = tflog.SetField(ctx, "number", state.Number.ValueInt64())
+	ctx = tflog.SetField(ctx, "review", state.ID.ValueString())
+
+	// A review cannot be deleted once it has been posted on a deploy request. Removing the resource from the
+	// configuration therefore only removes it from the Terraform state.
+	tflog.Debug(ctx, "removed deploy request review from state")
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *deployRequestReviewResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*planetscale.Client)
+}
@@ -2,8 +2,10 @@ package planetscale
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/planetscale/planetscale-go/planetscale"
@@ -11,10 +13,13 @@ import (
 
 // deployRequestsDataSourceModel maps the data source schema data.
 type deployRequestsDataSourceModel struct {
-	Organization  types.String       `tfsdk:"organization"`
-	Database      types.String       `tfsdk:"database"`
-	Number        types.String       `tfsdk:"number"`
-	DeployRequest deployRequestModel `tfsdk:"deploy_request"`
+	Organization    types.String         `tfsdk:"organization"`
+	Database        types.String         `tfsdk:"database"`
+	State           types.String         `tfsdk:"state"`
+	Branch          types.String         `tfsdk:"branch"`
+	IntoBranch      types.String         `tfsdk:"into_branch"`
+	DeploymentState types.String         `tfsdk:"deployment_state"`
+	DeployRequests  []deployRequestModel `tfsdk:"deploy_requests"`
 }
 
 func NewDeployRequestsDataSource() datasource.DataSource {
@@ -38,7 +43,10 @@ func (d *deployRequestsDataSource) Metadata(_ context.Context, req datasource.Me
 // Schema defines the schema for the data source.
 func (d *deployRequestsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "List of deploy requests for the given database.",
+		Description: "List of deploy requests for the given database. The deploy requests can optionally be filtered by " +
+			"their state, branches and deployment state. The Planetscale Golang SDK only returns the first page of " +
+			"deploy requests and the filters are applied to that page, therefore older deploy requests of databases " +
+			"with many deploy requests may be missing from the list.",
 		Attributes: map[string]schema.Attribute{
 			"organization": schema.StringAttribute{
 				Required:    true,
@@ -48,56 +56,85 @@ func (d *deployRequestsDataSource) Schema(_ context.Context, _ datasource.Schema
 				Required:    true,
 				Description: "The name of the database to list deploy requests for.",
 			},
-			"number": schema.StringAttribute{
-				Required:    true,
-				Description: "The number of the deploy request to get.",
+			"state": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list deploy requests in the given state. Supported values: open, closed.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"open",
+						"closed",
+					),
+				},
 			},
-			"deploy_request": schema.SingleNestedAttribute{
-				Computed: true,
-				Attributes: map[string]schema.Attribute{
-					"id": schema.StringAttribute{
-						Computed:    true,
-						Description: "The ID of the deploy request.",
-					},
-					"number": schema.Int64Attribute{
-						Computed:    true,
-						Description: "The number of the deploy request.",
-					},
-					"branch": schema.StringAttribute{
-						Computed:    true,
-						Description: "The name of the branch to start the deploy request onto.",
-					},
-					"into_branch": schema.StringAttribute{
-						Computed:    true,
-						Description: "The name of the branch to merge the deploy request into.",
-					},
-					"notes": schema.StringAttribute{
-						Computed:    true,
-						Description: "The notes for the deploy request.",
-					},
-					"state": schema.StringAttribute{
-						Computed:    true,
-						Description: "The state of the deploy request.",
-					},
-					"deployment_state": schema.StringAttribute{
-						Computed:    true,
-						Description: "The deployment state of the deploy request.",
-					},
-					"approved": schema.BoolAttribute{
-						Computed:    true,
-						Description: "Whether the deploy request has been approved.",
-					},
-					"html_url": schema.StringAttribute{
-						Computed:    true,
-						Description: "The URL of the deploy request.",
-					},
-					"created_at": schema.StringAttribute{
-						Computed:    true,
-						Description: "The time the deploy request was created.",
-					},
-					"updated_at": schema.StringAttribute{
-						Computed:    true,
-						Description: "The time the deploy request was last updated.",
+			"branch": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list deploy requests opened from the given branch.",
+			},
+			"into_branch": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list deploy requests merging into the given branch.",
+			},
+			"deployment_state": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list deploy requests in the given deployment state, e.g. pending, queued or complete.",
+			},
+			"deploy_requests": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The deploy requests matching the given filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"organization": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the organization.",
+						},
+						"database": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the database.",
+						},
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the deploy request.",
+						},
+						"number": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of the deploy request.",
+						},
+						"branch": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the branch to start the deploy request onto.",
+						},
+						"into_branch": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the branch to merge the deploy request into.",
+						},
+						"notes": schema.StringAttribute{
+							Computed:    true,
+							Description: "The notes for the deploy request.",
+						},
+						"state": schema.StringAttribute{
+							Computed:    true,
+							Description: "The state of the deploy request.",
+						},
+						"deployment_state": schema.StringAttribute{
+							Computed:    true,
+							Description: "The deployment state of the deploy request.",
+						},
+						"approved": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the deploy request has been approved.",
+						},
+						"html_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "The URL of the deploy request.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The time the deploy request was created.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The time the deploy request was last updated.",
+						},
 					},
 				},
 			},
@@ -112,14 +149,12 @@ func (d *deployRequestsDataSource) Read(ctx context.Context, req datasource.Read
 
 	ctx = tflog.SetField(ctx, "organization", state.Organization.ValueString())
 	ctx = tflog.SetField(ctx, "database", state.Database.ValueString())
-	ctx = tflog.SetField(ctx, "number", state.Number.ValueString())
 
-	tflog.Info(ctx, "requesting deploy request info")
+	tflog.Info(ctx, "requesting deploy requests listing from Planetscale")
 
-	deployRequest, err := d.client.DeployRequests.Get(ctx, &planetscale.GetDeployRequestRequest{
+	deployRequests, err := d.client.DeployRequests.List(ctx, &planetscale.ListDeployRequestsRequest{
 		Organization: state.Organization.ValueString(),
 		Database:     state.Database.ValueString(),
-		Number:       0,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -130,17 +165,41 @@ func (d *deployRequestsDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	state.DeployRequest.ID = types.StringValue(deployRequest.ID)
-	state.DeployRequest.Number = types.Int64Value(int64(deployRequest.Number))
-	state.DeployRequest.Branch = types.StringValue(deployRequest.Branch)
-	state.DeployRequest.IntoBranch = types.StringValue(deployRequest.IntoBranch)
-	state.DeployRequest.Notes = types.StringValue(deployRequest.Notes)
-	state.DeployRequest.State = types.StringValue(deployRequest.State)
-	state.DeployRequest.DeploymentState = types.StringValue(deployRequest.DeploymentState)
-	state.DeployRequest.Approved = types.BoolValue(deployRequest.Approved)
-	state.DeployRequest.HTMLURL = types.StringValue(deployRequest.HtmlURL)
-	state.DeployRequest.CreatedAt = types.StringValue(deployRequest.CreatedAt.String())
-	state.DeployRequest.UpdatedAt = types.StringValue(deployRequest.UpdatedAt.String())
+	// The golang sdk (https://github.com/planetscale/planetscale-go) does not support filtering deploy requests yet,
+	// therefore the filters are applied on the listed deploy requests.
+	for _, deployRequest := range deployRequests {
+		if !state.State.IsNull() && state.State.ValueString() != deployRequest.State {
+			continue
+		}
+		if !state.Branch.IsNull() && state.Branch.ValueString() != deployRequest.Branch {
+			continue
+		}
+		if !state.IntoBranch.IsNull() && state.IntoBranch.ValueString() != deployRequest.IntoBranch {
+			continue
+		}
+		if !state.DeploymentState.IsNull() && state.DeploymentState.ValueString() != deployRequest.DeploymentState {
+			continue
+		}
+
+		deployRequestState := deployRequestModel{
+			Organization:    state.Organization,
+			Database:        state.Database,
+			ID:              types.StringValue(deployRequest.ID),
+			Number:          types.Int64Value(int64(deployRequest.Number)),
+			Branch:          types.StringValue(deployRequest.Branch),
+			IntoBranch:      types.StringValue(deployRequest.IntoBranch),
+			Notes:           types.StringValue(deployRequest.Notes),
+			State:           types.StringValue(deployRequest.State),
+			DeploymentState: types.StringValue(deployRequest.DeploymentState),
+			Approved:        types.BoolValue(deployRequest.Approved),
+			HTMLURL:         types.StringValue(deployRequest.HtmlURL),
+			CreatedAt:       types.StringValue(deployRequest.CreatedAt.String()),
+			UpdatedAt:       types.StringValue(deployRequest.UpdatedAt.String()),
+		}
+		state.DeployRequests = append(state.DeployRequests, deployRequestState)
+	}
+
+	tflog.Debug(ctx, "returning deploy requests listing from Planetscale")
 
 	// Set state
 	diags := resp.State.Set(ctx, &state)
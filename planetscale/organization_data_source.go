@@ -0,0 +1,128 @@
+package planetscale
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/planetscale/planetscale-go/planetscale"
+)
+
+// organizationDatabasesPageSize is the number of databases requested per page when counting databases.
+const organizationDatabasesPageSize = 100
+
+// organizationDataSourceModel maps the data source schema data.
+type organizationDataSourceModel struct {
+	Name          types.String `tfsdk:"name"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+	DatabaseCount types.Int64  `tfsdk:"database_count"`
+}
+
+func NewOrganizationDataSource() datasource.DataSource {
+	return &organizationDataSource{}
+}
+
+type organizationDataSource struct {
+	client *planetscale.Client
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &organizationDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationDataSource{}
+)
+
+func (d *organizationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+// Schema defines the schema for the data source.
+func (d *organizationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Information about a single organization. At this time, the plan, billing and SSO settings of the " +
+			"organization are not available through the Planetscale Go SDK and therefore cannot be read.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the organization.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the organization was created.",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the organization was last updated.",
+			},
+			"database_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of databases in the organization.",
+			},
+		},
+	}
+}
+
+func (d *organizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state organizationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	ctx = tflog.SetField(ctx, "organization", state.Name.ValueString())
+
+	tflog.Info(ctx, "requesting organization info from Planetscale")
+	organization, err := d.client.Organizations.Get(ctx, &planetscale.GetOrganizationRequest{
+		Organization: state.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read Planetscale organization. Make sure your credentials are correct and you have access "+
+				"to the organization.",
+			err.Error(),
+		)
+		return
+	}
+
+	// Count the databases page by page, as a single page holds a limited number of databases
+	databaseCount := 0
+	for page := 1; ; page++ {
+		databases, err := d.client.Databases.List(ctx, &planetscale.ListDatabasesRequest{
+			Organization: state.Name.ValueString(),
+		}, planetscale.WithPage(page), planetscale.WithPerPage(organizationDatabasesPageSize))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read Planetscale databases of the organization. Make sure you have the correct "+
+					"permissions to list databases in the organization.",
+				err.Error(),
+			)
+			return
+		}
+
+		databaseCount += len(databases)
+		if len(databases) < organizationDatabasesPageSize {
+			break
+		}
+	}
+
+	state.Name = types.StringValue(organization.Name)
+	state.CreatedAt = types.StringValue(organization.CreatedAt.String())
+	state.UpdatedAt = types.StringValue(organization.UpdatedAt.String())
+	state.DatabaseCount = types.Int64Value(int64(databaseCount))
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *organizationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*planetscale.Client)
+}
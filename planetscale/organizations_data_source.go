@@ -0,0 +1,112 @@
+package planetscale
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/planetscale/planetscale-go/planetscale"
+)
+
+// organizationsDataSourceModel maps the data source schema data.
+type organizationsDataSourceModel struct {
+	Organizations []organizationModel `tfsdk:"organizations"`
+}
+
+// organizationModel maps organization schema data.
+type organizationModel struct {
+	Name      types.String `tfsdk:"name"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func NewOrganizationsDataSource() datasource.DataSource {
+	return &organizationsDataSource{}
+}
+
+type organizationsDataSource struct {
+	client *planetscale.Client
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &organizationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationsDataSource{}
+)
+
+func (d *organizationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organizations"
+}
+
+// Schema defines the schema for the data source.
+func (d *organizationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List of organizations the configured credentials have access to. The Planetscale Golang SDK " +
+			"only returns the name and the creation and update times of an organization, therefore the plan of " +
+			"the organizations is not available.",
+		Attributes: map[string]schema.Attribute{
+			"organizations": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of organizations.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the organization.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The time the organization was created.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The time the organization was last updated.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *organizationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state organizationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	tflog.Info(ctx, "requesting organizations listing from Planetscale")
+	organizations, err := d.client.Organizations.List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read Planetscale organizations. Make sure your credentials are correct.",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, organization := range organizations {
+		organizationState := organizationModel{
+			Name:      types.StringValue(organization.Name),
+			CreatedAt: types.StringValue(organization.CreatedAt.String()),
+			UpdatedAt: types.StringValue(organization.UpdatedAt.String()),
+		}
+		state.Organizations = append(state.Organizations, organizationState)
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *organizationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*planetscale.Client)
+}
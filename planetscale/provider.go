@@ -161,9 +161,15 @@ func (p *planetscaleProvider) DataSources(_ context.Context) []func() datasource
 		NewDatabasesDataSource,
 		NewRegionsDataSource,
 		NewDatabaseBranchesDataSource,
+		NewDatabaseBranchPasswordsDataSource,
 		NewDatabaseBranchPasswordDataSource,
 		NewBackupsDataSource,
 		NewDeployRequestsDataSource,
+		NewDeployRequestDataSource,
+		NewServiceTokensDataSource,
+		NewAuditLogsDataSource,
+		NewOrganizationDataSource,
+		NewOrganizationsDataSource,
 	}
 }
 
@@ -173,7 +179,12 @@ func (p *planetscaleProvider) Resources(_ context.Context) []func() resource.Res
 		NewDatabaseResource,
 		NewDatabaseBranchResource,
 		NewDatabaseBranchPasswordResource,
+		NewDatabaseBranchCertificateResource,
+		NewDatabaseImportResource,
 		NewBackupResource,
 		NewDeployRequestResource,
+		NewDeployRequestReviewResource,
+		NewServiceTokenResource,
+		NewServiceTokenAccessResource,
 	}
 }
@@ -0,0 +1,323 @@
+package planetscale
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/planetscale/planetscale-go/planetscale"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// serviceTokenDatabaseAccessType is the type of the service token accesses granted on a database.
+const serviceTokenDatabaseAccessType = "DatabaseAccess"
+
+var (
+	_ resource.Resource              = &serviceTokenAccessResource{}
+	_ resource.ResourceWithConfigure = &serviceTokenAccessResource{}
+)
+
+type serviceTokenAccessResourceModel struct {
+	Organization   types.String `tfsdk:"organization"`
+	ServiceTokenID types.String `tfsdk:"service_token_id"`
+	Database       types.String `tfsdk:"database"`
+	Accesses       types.Set    `tfsdk:"accesses"`
+}
+
+// NewServiceTokenAccessResource is a helper function to simplify the provider implementation.
+func NewServiceTokenAccessResource() resource.Resource {
+	return &serviceTokenAccessResource{}
+}
+
+// serviceTokenAccessResource is the resource implementation.
+type serviceTokenAccessResource struct {
+	client *planetscale.Client
+}
+
+// Metadata returns the resource type name.
+func (r *serviceTokenAccessResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_token_access"
+}
+
+// Schema defines the schema for the resource.
+func (r *serviceTokenAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The accesses granted to a Planetscale service token, either on a database or on the whole " +
+			"organization. Any access granted outside of Terraform on the same database is detected as drift and " +
+			"removed on the next apply. More info: https://planetscale.com/docs/concepts/service-tokens",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the organization the service token belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_token_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the service token to grant the accesses to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Optional: true,
+				Description: "The name of the database to grant the accesses on. If not specified, the accesses are " +
+					"granted on the organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"accesses": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The accesses to grant, e.g. read_branch, create_deploy_request or " +
+					"connect_production_branch. For the full list of accesses, please see here: " +
+					"https://api-docs.planetscale.com/reference/service-tokens#access-permissions.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *serviceTokenAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan serviceTokenAccessResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var accesses []string
+	resp.Diagnostics.Append(plan.Accesses.ElementsAs(ctx, &accesses, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// create resource on Planetscale
+	_, err := r.client.ServiceTokens.AddAccess(ctx, &planetscale.AddServiceTokenAccessRequest{
+		Organization: plan.Organization.ValueString(),
+		ID:           plan.ServiceTokenID.ValueString(),
+		Database:     plan.Database.ValueString(),
+		Accesses:     accesses,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error granting service token access",
+			"Could not grant access to service token, unexpected error: "+err.Error()+". Make sure the service "+
+				"token exists, the accesses are valid and that you have the correct permissions.",
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *serviceTokenAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state serviceTokenAccessResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed service token accesses from Planetscale
+	serviceTokenAccesses, err := r.client.ServiceTokens.GetAccess(ctx, &planetscale.GetServiceTokenAccessRequest{
+		Organization: state.Organization.ValueString(),
+		ID:           state.ServiceTokenID.ValueString(),
+	})
+	var psErr *planetscale.Error
+	if errors.As(err, &psErr) && psErr.Code == planetscale.ErrNotFound {
+		tflog.Warn(ctx, "service token not found, removing its accesses from state", map[string]interface{}{
+			"service_token_id": state.ServiceTokenID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Planetscale service token access",
+			"Could not read accesses of Planetscale service token "+state.ServiceTokenID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Only the accesses granted on the configured database, or on the organization if no database is configured,
+	// are managed by this resource. Matching on the type as well keeps the grants of a database named like the
+	// organization apart from the organization grants.
+	granted := []string{}
+	for _, serviceTokenAccess := range serviceTokenAccesses {
+		databaseAccess := serviceTokenAccess.Type == serviceTokenDatabaseAccessType
+		if state.Database.IsNull() {
+			if !databaseAccess && serviceTokenAccess.Resource.Name == state.Organization.ValueString() {
+				granted = append(granted, serviceTokenAccess.Access)
+			}
+		} else if databaseAccess && serviceTokenAccess.Resource.Name == state.Database.ValueString() {
+			granted = append(granted, serviceTokenAccess.Access)
+		}
+	}
+
+	// Overwrite items with refreshed state
+	accesses, diags := types.SetValueFrom(ctx, types.StringType, granted)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Accesses = accesses
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *serviceTokenAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and current state
+	var plan, state serviceTokenAccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planned, current []string
+	resp.Diagnostics.Append(plan.Accesses.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.Accesses.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "organization", plan.Organization.ValueString())
+	ctx = tflog.SetField(ctx, "service_token_id", plan.ServiceTokenID.ValueString())
+	ctx = tflog.SetField(ctx, "database", plan.Database.ValueString())
+
+	if toAdd := stringsDifference(planned, current); len(toAdd) > 0 {
+		tflog.Info(ctx, "granting service token accesses", map[string]interface{}{"accesses": toAdd})
+		_, err := r.client.ServiceTokens.AddAccess(ctx, &planetscale.AddServiceTokenAccessRequest{
+			Organization: plan.Organization.ValueString(),
+			ID:           plan.ServiceTokenID.ValueString(),
+			Database:     plan.Database.ValueString(),
+			Accesses:     toAdd,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error granting service token access",
+				"Could not grant access to service token, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if toRemove := stringsDifference(current, planned); len(toRemove) > 0 {
+		tflog.Info(ctx, "removing service token accesses", map[string]interface{}{"accesses": toRemove})
+		err := r.client.ServiceTokens.DeleteAccess(ctx, &planetscale.DeleteServiceTokenAccessRequest{
+			Organization: plan.Organization.ValueString(),
+			ID:           plan.ServiceTokenID.ValueString(),
+			Database:     plan.Database.ValueString(),
+			Accesses:     toRemove,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error removing service token access",
+				"Could not remove access from service token, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Set state to fully populated data
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *serviceTokenAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state serviceTokenAccessResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var accesses []string
+	resp.Diagnostics.Append(state.Accesses.ElementsAs(ctx, &accesses, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the granted accesses from the Planetscale service token
+	ctx = tflog.SetField(ctx, "organization", state.Organization.ValueString())
+	ctx = tflog.SetField(ctx, "service_token_id", state.ServiceTokenID.ValueString())
+	ctx = tflog.SetField(ctx, "database", state.Database.ValueString())
+
+	if len(accesses) == 0 {
+		tflog.Debug(ctx, "no service token accesses to remove")
+		return
+	}
+
+	err := r.client.ServiceTokens.DeleteAccess(ctx, &planetscale.DeleteServiceTokenAccessRequest{
+		Organization: state.Organization.ValueString(),
+		ID:           state.ServiceTokenID.ValueString(),
+		Database:     state.Database.ValueString(),
+		Accesses:     accesses,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error removing Planetscale service token access",
+			"Could not remove access from service token, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "removed Planetscale service token accesses")
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *serviceTokenAccessResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*planetscale.Client)
+}
+
+// stringsDifference returns the elements of a which are not in b.
+func stringsDifference(a, b []string) []string {
+	seen := make(map[string]struct{}, len(b))
+	for _, s := range b {
+		seen[s] = struct{}{}
+	}
+
+	var diff []string
+	for _, s := range a {
+		if _, ok := seen[s]; !ok {
+			diff = append(diff, s)
+		}
+	}
+	return diff
+}
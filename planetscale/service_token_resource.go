@@ -0,0 +1,200 @@
+package planetscale
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/planetscale/planetscale-go/planetscale"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var (
+	_ resource.Resource              = &serviceTokenResource{}
+	_ resource.ResourceWithConfigure = &serviceTokenResource{}
+)
+
+type serviceTokenResourceModel struct {
+	Organization types.String `tfsdk:"organization"`
+	ID           types.String `tfsdk:"id"`
+	Type         types.String `tfsdk:"type"`
+	Token        types.String `tfsdk:"token"`
+}
+
+// NewServiceTokenResource is a helper function to simplify the provider implementation.
+func NewServiceTokenResource() resource.Resource {
+	return &serviceTokenResource{}
+}
+
+// serviceTokenResource is the resource implementation.
+type serviceTokenResource struct {
+	client *planetscale.Client
+}
+
+// Metadata returns the resource type name.
+func (r *serviceTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_token"
+}
+
+// Schema defines the schema for the resource.
+func (r *serviceTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A Planetscale service token. Service tokens are used to authenticate against the Planetscale API " +
+			"without a user account. A newly created service token has no access until access is granted to it. More " +
+			"info: https://planetscale.com/docs/concepts/service-tokens",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the organization to create the service token in.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the service token.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the service token.",
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				Description: "The service token. The token is only returned by Planetscale when the service token is " +
+					"created.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *serviceTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan serviceTokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// create resource on Planetscale
+	serviceToken, err := r.client.ServiceTokens.Create(ctx, &planetscale.CreateServiceTokenRequest{
+		Organization: plan.Organization.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating service token",
+			"Could not create service token, unexpected error: "+err.Error()+". Make sure you have the correct "+
+				"permissions to create service tokens in the organization.",
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(serviceToken.ID)
+	plan.Type = types.StringValue(serviceToken.Type)
+	plan.Token = types.StringValue(serviceToken.Token)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *serviceTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state serviceTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A single service token cannot be fetched, therefore look it up in the organization's service tokens
+	serviceTokens, err := r.client.ServiceTokens.List(ctx, &planetscale.ListServiceTokensRequest{
+		Organization: state.Organization.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Planetscale service token",
+			"Could not read info about Planetscale service token "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	var serviceToken *planetscale.ServiceToken
+	for _, t := range serviceTokens {
+		if t.ID == state.ID.ValueString() {
+			serviceToken = t
+			break
+		}
+	}
+
+	if serviceToken == nil {
+		tflog.Warn(ctx, "service token not found, removing it from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.Type = types.StringValue(serviceToken.Type)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *serviceTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// a service token has no attributes which can be updated in place
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *serviceTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state serviceTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing Planetscale service token
+	ctx = tflog.SetField(ctx, "organization", state.Organization.ValueString())
+	ctx = tflog.SetField(ctx, "id", state.ID.ValueString())
+
+	err := r.client.ServiceTokens.Delete(ctx, &planetscale.DeleteServiceTokenRequest{
+		Organization: state.Organization.ValueString(),
+		ID:           state.ID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Planetscale service token",
+			"Could not delete service token, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "deleted Planetscale service token")
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *serviceTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*planetscale.Client)
+}
@@ -0,0 +1,173 @@
+package planetscale
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/planetscale/planetscale-go/planetscale"
+)
+
+// serviceTokensDataSourceModel maps the data source schema data.
+type serviceTokensDataSourceModel struct {
+	Organization  types.String        `tfsdk:"organization"`
+	ServiceTokens []serviceTokenModel `tfsdk:"service_tokens"`
+}
+
+// serviceTokenModel maps service token schema data.
+type serviceTokenModel struct {
+	ID       types.String              `tfsdk:"id"`
+	Type     types.String              `tfsdk:"type"`
+	Accesses []serviceTokenAccessModel `tfsdk:"accesses"`
+}
+
+// serviceTokenAccessModel maps service token access schema data.
+type serviceTokenAccessModel struct {
+	ID       types.String `tfsdk:"id"`
+	Access   types.String `tfsdk:"access"`
+	Type     types.String `tfsdk:"type"`
+	Resource types.String `tfsdk:"resource"`
+}
+
+func NewServiceTokensDataSource() datasource.DataSource {
+	return &serviceTokensDataSource{}
+}
+
+type serviceTokensDataSource struct {
+	client *planetscale.Client
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &serviceTokensDataSource{}
+	_ datasource.DataSourceWithConfigure = &serviceTokensDataSource{}
+)
+
+func (d *serviceTokensDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_tokens"
+}
+
+// Schema defines the schema for the data source.
+func (d *serviceTokensDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List of service tokens in the organization, along with the accesses granted to each of them. For " +
+			"more information on service tokens, see here: https://planetscale.com/docs/concepts/service-tokens.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the organization to list service tokens for.",
+			},
+			"service_tokens": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of service tokens.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the service token.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the service token.",
+						},
+						"accesses": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The accesses granted to the service token.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed:    true,
+										Description: "The ID of the access.",
+									},
+									"access": schema.StringAttribute{
+										Computed:    true,
+										Description: "The name of the access, e.g. read_branch.",
+									},
+									"type": schema.StringAttribute{
+										Computed:    true,
+										Description: "The type of the access.",
+									},
+									"resource": schema.StringAttribute{
+										Computed: true,
+										Description: "The name of the resource the access is granted on, either a " +
+											"database or the organization.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *serviceTokensDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state serviceTokensDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	ctx = tflog.SetField(ctx, "organization", state.Organization.ValueString())
+
+	tflog.Info(ctx, "requesting service tokens listing from Planetscale")
+	serviceTokens, err := d.client.ServiceTokens.List(ctx, &planetscale.ListServiceTokensRequest{
+		Organization: state.Organization.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read Planetscale service tokens. Make sure your credentials are correct and you have "+
+				"the correct permissions to list service tokens in the organization.",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, serviceToken := range serviceTokens {
+		serviceTokenAccesses, err := d.client.ServiceTokens.GetAccess(ctx, &planetscale.GetServiceTokenAccessRequest{
+			Organization: state.Organization.ValueString(),
+			ID:           serviceToken.ID,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read accesses of Planetscale service token "+serviceToken.ID+". Make sure you have the "+
+					"correct permissions to read service token accesses in the organization.",
+				err.Error(),
+			)
+			return
+		}
+
+		serviceTokenState := serviceTokenModel{
+			ID:       types.StringValue(serviceToken.ID),
+			Type:     types.StringValue(serviceToken.Type),
+			Accesses: []serviceTokenAccessModel{},
+		}
+		for _, serviceTokenAccess := range serviceTokenAccesses {
+			serviceTokenState.Accesses = append(serviceTokenState.Accesses, serviceTokenAccessModel{
+				ID:       types.StringValue(serviceTokenAccess.ID),
+				Access:   types.StringValue(serviceTokenAccess.Access),
+				Type:     types.StringValue(serviceTokenAccess.Type),
+				Resource: types.StringValue(serviceTokenAccess.Resource.Name),
+			})
+		}
+		state.ServiceTokens = append(state.ServiceTokens, serviceTokenState)
+	}
+
+	tflog.Debug(ctx, "returning service tokens listing from Planetscale")
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *serviceTokensDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*planetscale.Client)
+}